@@ -0,0 +1,86 @@
+package noire
+
+import "math"
+
+// apcaLuminance returns the screen luminance of the RGB color with the soft clamp of the black level, based on APCA-W3 0.0.98G-4g.
+func apcaLuminance(r float64, g float64, b float64) float64 {
+	y := 0.2126729*math.Pow(r/255, 2.4) + 0.7151522*math.Pow(g/255, 2.4) + 0.0721750*math.Pow(b/255, 2.4)
+	if y < 0.022 {
+		y += math.Pow(0.022-y, 1.414)
+	}
+	return y
+}
+
+// ContrastAPCA returns the APCA lightness contrast (Lc) of the current color as the text on the background color, based on APCA-W3 0.0.98G-4g.
+// It's positive for the dark text on a light background and negative for the light text on a dark background (from about `106` to `-108`),
+// the absolute value is used to check the readability (see `APCAMinFontSize`). The text color is blended on the background if it's translucent.
+//
+// reference: https://github.com/Myndex/apca-w3
+func (c Color) ContrastAPCA(background Color) float64 {
+	r := c.Red*c.Alpha + background.Red*(1-c.Alpha)
+	g := c.Green*c.Alpha + background.Green*(1-c.Alpha)
+	b := c.Blue*c.Alpha + background.Blue*(1-c.Alpha)
+	text := apcaLuminance(r, g, b)
+	bg := apcaLuminance(background.Red, background.Green, background.Blue)
+	if math.Abs(bg-text) < 0.0005 {
+		return 0
+	}
+	var v float64
+	if bg > text {
+		v = (math.Pow(bg, 0.56) - math.Pow(text, 0.57)) * 1.14
+		if v < 0.1 {
+			return 0
+		}
+		v -= 0.027
+	} else {
+		v = (math.Pow(bg, 0.65) - math.Pow(text, 0.62)) * 1.14
+		if v > -0.1 {
+			return 0
+		}
+		v += 0.027
+	}
+	return v * 100
+}
+
+// apcaFonts are the minimum font sizes (px) of the font weights from `100` to `900` at the Lc levels of the APCA Bronze Simple Mode, `0` means the weight is not readable at the level.
+var apcaFonts = []struct {
+	lc    float64
+	sizes [9]float64
+}{
+	{90, [9]float64{0, 0, 18, 14, 14, 14, 14, 14, 14}},
+	{75, [9]float64{0, 0, 24, 18, 16, 16, 14, 14, 14}},
+	{60, [9]float64{0, 48, 36, 24, 21, 18, 16, 16, 16}},
+	{45, [9]float64{0, 0, 0, 36, 36, 36, 24, 24, 24}},
+}
+
+// APCAMinFontSize returns the minimum font size (px) of the font weight (from `100` to `900`) for the text to be readable at the Lc contrast, based on the APCA Bronze Simple Mode.
+// It returns `0` if the contrast is too low for the text of the weight, an Lc lower than `45` is only enough for the spot text (like: placeholders) and the non-text elements.
+//
+// reference: https://readtech.org/ARC/tests/bronze-simple-mode/
+func APCAMinFontSize(lc float64, weight int) float64 {
+	i := int(math.Round(float64(weight)/100)) - 1
+	if i < 0 {
+		i = 0
+	} else if i > 8 {
+		i = 8
+	}
+	lc = math.Abs(lc)
+	var size float64
+	for _, v := range apcaFonts {
+		if lc >= v.lc && v.sizes[i] != 0 && (size == 0 || v.sizes[i] < size) {
+			size = v.sizes[i]
+		}
+	}
+	return size
+}
+
+// APCAMinFontWeight returns the minimum font weight (from `100` to `900`) for the text of the font size (px) to be readable at the Lc contrast,
+// based on the APCA Bronze Simple Mode. It returns `0` if the contrast is too low for the text of the size.
+func APCAMinFontWeight(lc float64, size float64) int {
+	for w := 100; w <= 900; w += 100 {
+		if s := APCAMinFontSize(lc, w); s != 0 && s <= size {
+			return w
+		}
+	}
+	return 0
+}
@@ -0,0 +1,48 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContrastAPCA(t *testing.T) {
+	assert := assert.New(t)
+	white, black := NewHex("FFF"), NewHex("000")
+	assert.InDelta(106.04, black.ContrastAPCA(white), 0.01)
+	assert.InDelta(-107.88, white.ContrastAPCA(black), 0.01)
+	assert.InDelta(63.06, NewHex("888").ContrastAPCA(white), 0.01)
+	assert.InDelta(-68.54, white.ContrastAPCA(NewHex("888")), 0.01)
+	assert.InDelta(91.67, NewHex("123").ContrastAPCA(NewHex("DEF")), 0.01)
+	assert.InDelta(-93.07, NewHex("DEF").ContrastAPCA(NewHex("123")), 0.01)
+	assert.Equal(0.0, white.ContrastAPCA(white))
+	assert.Equal(0.0, NewHex("FAFAFA").ContrastAPCA(white))
+
+	// The translucent text is blended on the background.
+	assert.Equal(NewHex("808080").ContrastAPCA(white), NewRGBA(1, 1, 1, 0.5).ContrastAPCA(white))
+}
+
+func TestAPCAMinFontSize(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(14.0, APCAMinFontSize(90, 400))
+	assert.Equal(18.0, APCAMinFontSize(-90, 300))
+	assert.Equal(18.0, APCAMinFontSize(80, 400))
+	assert.Equal(24.0, APCAMinFontSize(63, 400))
+	assert.Equal(48.0, APCAMinFontSize(95, 200))
+	assert.Equal(36.0, APCAMinFontSize(45, 400))
+	assert.Equal(24.0, APCAMinFontSize(45, 700))
+	assert.Equal(0.0, APCAMinFontSize(45, 300))
+	assert.Equal(0.0, APCAMinFontSize(30, 900))
+	assert.Equal(0.0, APCAMinFontSize(106, 100))
+	assert.Equal(14.0, APCAMinFontSize(106, 1000))
+}
+
+func TestAPCAMinFontWeight(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(400, APCAMinFontWeight(90, 14))
+	assert.Equal(300, APCAMinFontWeight(90, 18))
+	assert.Equal(700, APCAMinFontWeight(75, 14))
+	assert.Equal(200, APCAMinFontWeight(60, 48))
+	assert.Equal(0, APCAMinFontWeight(60, 12))
+	assert.Equal(0, APCAMinFontWeight(30, 48))
+}
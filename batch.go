@@ -0,0 +1,144 @@
+package noire
+
+import (
+	"image"
+	"math"
+)
+
+// triplets reslices both slices to the interleaved triplets that both of them can hold, it returns the count of the triplets.
+func triplets(dst []float64, src []float64) ([]float64, []float64, int) {
+	n := len(src)
+	if len(dst) < n {
+		n = len(dst)
+	}
+	n /= 3
+	return dst[:n*3], src[:n*3], n
+}
+
+// ConvertRGBToHSLExact converts the interleaved RGB triplets (like: `r, g, b, r, g, b`) of `src` to the HSL triplets of `dst` without rounding the results, the same as `RGBToHSLExact`.
+// It converts as many triplets as both slices can hold and returns the count, `dst` and `src` can be the same slice to convert in place. It doesn't allocate.
+func ConvertRGBToHSLExact(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	rgbToHSL(dst, src)
+	return n
+}
+
+// ConvertHSLToRGBExact converts the interleaved HSL triplets of `src` to the RGB triplets of `dst` without rounding the results, the same as `HSLToRGBExact` (see `ConvertRGBToHSLExact`).
+func ConvertHSLToRGBExact(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		s, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		d[0], d[1], d[2] = HSLToRGBExact(s[0], s[1], s[2])
+	}
+	return n
+}
+
+// ConvertRGBToHSVExact converts the interleaved RGB triplets of `src` to the HSV triplets of `dst` without rounding the results, the same as `RGBToHSVExact` (see `ConvertRGBToHSLExact`).
+func ConvertRGBToHSVExact(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	rgbToHSV(dst, src)
+	return n
+}
+
+// ConvertHSVToRGBExact converts the interleaved HSV triplets of `src` to the RGB triplets of `dst` without rounding the results, the same as `HSVToRGBExact` (see `ConvertRGBToHSLExact`).
+func ConvertHSVToRGBExact(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		s, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		d[0], d[1], d[2] = HSVToRGBExact(s[0], s[1], s[2])
+	}
+	return n
+}
+
+// ConvertRGBToLab converts the interleaved RGB triplets of `src` to the CIELAB (D65) triplets of `dst` without rounding the results, the same as `RGBToLab` (see `ConvertRGBToHSLExact`).
+func ConvertRGBToLab(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		s, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		d[0], d[1], d[2] = RGBToLab(s[0], s[1], s[2], D65)
+	}
+	return n
+}
+
+// ConvertLabToRGB converts the interleaved CIELAB (D65) triplets of `src` to the RGB triplets of `dst` without rounding the results, the same as `LabToRGB` (see `ConvertRGBToHSLExact`).
+func ConvertLabToRGB(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		s, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		d[0], d[1], d[2] = LabToRGB(s[0], s[1], s[2], D65)
+	}
+	return n
+}
+
+// ConvertRGBToOKLab converts the interleaved RGB triplets of `src` to the OKLab triplets of `dst` without rounding the results, the same as `RGBToOKLab` (see `ConvertRGBToHSLExact`).
+func ConvertRGBToOKLab(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		s, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		d[0], d[1], d[2] = RGBToOKLab(s[0], s[1], s[2])
+	}
+	return n
+}
+
+// ConvertOKLabToRGB converts the interleaved OKLab triplets of `src` to the RGB triplets of `dst` without rounding the results, the same as `OKLabToRGB` (see `ConvertRGBToHSLExact`).
+func ConvertOKLabToRGB(dst []float64, src []float64) int {
+	dst, src, n := triplets(dst, src)
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		s, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		d[0], d[1], d[2] = OKLabToRGB(s[0], s[1], s[2])
+	}
+	return n
+}
+
+// MapColors returns the colors converted by the function, like: `MapColors(colors, Color.Grayscale)`.
+func MapColors(colors []Color, f func(Color) Color) []Color {
+	v := make([]Color, len(colors))
+	for i, c := range colors {
+		v[i] = f(c)
+	}
+	return v
+}
+
+// ToHexAll returns the uppercased Hex strings (without the `#` prefix) of the colors, the strings share a single buffer instead of being allocated one by one.
+func ToHexAll(colors []Color) []string {
+	const digits = "0123456789ABCDEF"
+	buf := make([]byte, 0, len(colors)*6)
+	for _, c := range colors {
+		for _, v := range [3]float64{c.Red, c.Green, c.Blue} {
+			b := uint8(math.Round(v))
+			buf = append(buf, digits[b>>4], digits[b&0xF])
+		}
+	}
+	s := string(buf)
+	v := make([]string, len(colors))
+	for i := range v {
+		v[i] = s[i*6 : i*6+6]
+	}
+	return v
+}
+
+// clampByte rounds and clamps the channel to the `0` - `255` range of a byte.
+func clampByte(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(255, v))))
+}
+
+// MapNRGBA converts every pixel in the bounds of the image by the function in place, it reads and writes the `Pix` buffer directly without allocations.
+func MapNRGBA(img *image.NRGBA, f func(Color) Color) {
+	b := img.Rect
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		i := img.PixOffset(b.Min.X, y)
+		row := img.Pix[i : i+b.Dx()*4]
+		for j := 0; j < len(row); j += 4 {
+			c := f(Color{
+				Red:   float64(row[j]),
+				Green: float64(row[j+1]),
+				Blue:  float64(row[j+2]),
+				Alpha: float64(row[j+3]) / 255,
+			})
+			row[j] = clampByte(c.Red)
+			row[j+1] = clampByte(c.Green)
+			row[j+2] = clampByte(c.Blue)
+			row[j+3] = clampByte(c.Alpha * 255)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package noire
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertRGBToHSLExact(t *testing.T) {
+	assert := assert.New(t)
+	src := []float64{219, 112, 147, 255, 0, 0}
+	dst := make([]float64, 6)
+	assert.Equal(2, ConvertRGBToHSLExact(dst, src))
+	h, s, l := RGBToHSLExact(219, 112, 147)
+	assert.Equal([]float64{h, s, l, 0, 100, 50}, dst)
+
+	// The extra values are ignored.
+	assert.Equal(1, ConvertRGBToHSLExact(make([]float64, 5), src))
+	assert.Equal(0, ConvertRGBToHSLExact(nil, src))
+
+	// The conversion can be done in place.
+	assert.Equal(2, ConvertRGBToHSLExact(src, src))
+	assert.Equal(dst, src)
+}
+
+func TestConvertExact(t *testing.T) {
+	assert := assert.New(t)
+	// The inline conversions must be the same as the single color functions.
+	var src []float64
+	for i := 0; i < 4096; i++ {
+		src = append(src, float64(i*37%256), float64(i*101%256)+0.5, float64(i%256))
+	}
+	src = append(src, 0, 0, 0, 255, 255, 255, 255, 0, 255, 10, 250, 250)
+	hsl, hsv := make([]float64, len(src)), make([]float64, len(src))
+	ConvertRGBToHSLExact(hsl, src)
+	ConvertRGBToHSVExact(hsv, src)
+	for i := 0; i < len(src); i += 3 {
+		h, s, l := RGBToHSLExact(src[i], src[i+1], src[i+2])
+		assert.Equal([]float64{h, s, l}, hsl[i:i+3])
+		h, s, v := RGBToHSVExact(src[i], src[i+1], src[i+2])
+		assert.Equal([]float64{h, s, v}, hsv[i:i+3])
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+	src := []float64{219, 112, 147, 255, 0, 0, 0, 0, 0, 12, 250, 99}
+	pairs := []struct {
+		to   func([]float64, []float64) int
+		from func([]float64, []float64) int
+	}{
+		{ConvertRGBToHSLExact, ConvertHSLToRGBExact},
+		{ConvertRGBToHSVExact, ConvertHSVToRGBExact},
+		{ConvertRGBToLab, ConvertLabToRGB},
+		{ConvertRGBToOKLab, ConvertOKLabToRGB},
+	}
+	for _, p := range pairs {
+		v := make([]float64, len(src))
+		assert.Equal(4, p.to(v, src))
+		assert.Equal(4, p.from(v, v))
+		assert.InDeltaSlice(src, v, 1e-3)
+	}
+
+	v := make([]float64, 3)
+	ConvertRGBToLab(v, []float64{219, 112, 147})
+	l, a, b := NewRGB(219, 112, 147).Lab()
+	assert.Equal([]float64{l, a, b}, v)
+}
+
+func TestMapColors(t *testing.T) {
+	assert := assert.New(t)
+	colors := []Color{NewHTML("Red"), NewHTML("White")}
+	v := MapColors(colors, Color.Invert)
+	assert.Equal([]string{"00FFFF", "000000"}, ToHexAll(v))
+	assert.Equal("FF0000", colors[0].Hex())
+	assert.Empty(MapColors(nil, Color.Invert))
+}
+
+func TestToHexAll(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"DB7093", "FF0000"}, ToHexAll([]Color{NewRGB(219, 112, 147), NewHTML("Red")}))
+	colors := []Color{NewRGB(0.4, 127.5, 254.6), NewRGB(10, 20, 30)}
+	assert.Equal([]string{colors[0].Hex(), colors[1].Hex()}, ToHexAll(colors))
+	assert.Empty(ToHexAll(nil))
+}
+
+func TestMapNRGBA(t *testing.T) {
+	assert := assert.New(t)
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.NRGBA{255, 0, 0, 255})
+	img.Set(1, 0, color.NRGBA{219, 112, 147, 128})
+	img.Set(0, 1, color.NRGBA{0, 0, 0, 0})
+	img.Set(1, 1, color.NRGBA{10, 20, 30, 255})
+
+	// Only the pixels in the bounds of the sub image are converted.
+	sub := img.SubImage(image.Rect(1, 0, 2, 2)).(*image.NRGBA)
+	MapNRGBA(sub, Color.Invert)
+	assert.Equal(color.NRGBA{255, 0, 0, 255}, img.NRGBAAt(0, 0))
+	assert.Equal(color.NRGBA{36, 143, 108, 128}, img.NRGBAAt(1, 0))
+	assert.Equal(color.NRGBA{0, 0, 0, 0}, img.NRGBAAt(0, 1))
+	assert.Equal(color.NRGBA{245, 235, 225, 255}, img.NRGBAAt(1, 1))
+
+	MapNRGBA(img, func(c Color) Color {
+		return Color{Red: c.Red * 2, Green: -1, Blue: c.Blue, Alpha: c.Alpha}
+	})
+	assert.Equal(color.NRGBA{255, 0, 0, 255}, img.NRGBAAt(0, 0))
+	assert.Equal(color.NRGBA{72, 0, 108, 128}, img.NRGBAAt(1, 0))
+}
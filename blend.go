@@ -0,0 +1,177 @@
+package noire
+
+import (
+	"math"
+	"sort"
+)
+
+// BlendMode is the way to blend the colors of a layer with the backdrop, based on the W3C Compositing and Blending spec.
+//
+// reference: https://www.w3.org/TR/compositing-1/#blending
+type BlendMode int
+
+const (
+	// BlendNormal takes the top color.
+	BlendNormal BlendMode = iota
+	// BlendMultiply multiplies the colors, the result is always darker.
+	BlendMultiply
+	// BlendScreen multiplies the complements of the colors, the result is always lighter.
+	BlendScreen
+	// BlendOverlay multiplies or screens the colors depending on the backdrop color.
+	BlendOverlay
+	// BlendDarken takes the darker channels of the colors.
+	BlendDarken
+	// BlendLighten takes the lighter channels of the colors.
+	BlendLighten
+	// BlendColorDodge brightens the backdrop color to reflect the top color.
+	BlendColorDodge
+	// BlendColorBurn darkens the backdrop color to reflect the top color.
+	BlendColorBurn
+	// BlendHardLight multiplies or screens the colors depending on the top color.
+	BlendHardLight
+	// BlendSoftLight darkens or lightens the colors depending on the top color, like a diffused spotlight.
+	BlendSoftLight
+	// BlendDifference subtracts the darker color from the lighter color.
+	BlendDifference
+	// BlendExclusion is like `BlendDifference` with a lower contrast.
+	BlendExclusion
+	// BlendHue takes the hue of the top color with the saturation and luminosity of the backdrop color.
+	BlendHue
+	// BlendSaturation takes the saturation of the top color with the hue and luminosity of the backdrop color.
+	BlendSaturation
+	// BlendColor takes the hue and saturation of the top color with the luminosity of the backdrop color.
+	BlendColor
+	// BlendLuminosity takes the luminosity of the top color with the hue and saturation of the backdrop color.
+	BlendLuminosity
+)
+
+// blendChannel blends a channel (`0` - `1`) of the backdrop and the source with the separable blend mode.
+func blendChannel(cb float64, cs float64, mode BlendMode) float64 {
+	switch mode {
+	case BlendMultiply:
+		return cb * cs
+	case BlendScreen:
+		return cb + cs - cb*cs
+	case BlendOverlay:
+		return blendChannel(cs, cb, BlendHardLight)
+	case BlendDarken:
+		return math.Min(cb, cs)
+	case BlendLighten:
+		return math.Max(cb, cs)
+	case BlendColorDodge:
+		if cb == 0 {
+			return 0
+		} else if cs >= 1 {
+			return 1
+		}
+		return math.Min(1, cb/(1-cs))
+	case BlendColorBurn:
+		if cb >= 1 {
+			return 1
+		} else if cs == 0 {
+			return 0
+		}
+		return 1 - math.Min(1, (1-cb)/cs)
+	case BlendHardLight:
+		if cs <= 0.5 {
+			return blendChannel(cb, 2*cs, BlendMultiply)
+		}
+		return blendChannel(cb, 2*cs-1, BlendScreen)
+	case BlendSoftLight:
+		if cs <= 0.5 {
+			return cb - (1-2*cs)*cb*(1-cb)
+		}
+		var d float64
+		if cb <= 0.25 {
+			d = ((16*cb-12)*cb + 4) * cb
+		} else {
+			d = math.Sqrt(cb)
+		}
+		return cb + (2*cs-1)*(d-cb)
+	case BlendDifference:
+		return math.Abs(cb - cs)
+	case BlendExclusion:
+		return cb + cs - 2*cb*cs
+	}
+	return cs
+}
+
+// blendLum returns the luminosity of the color for the non-separable blend modes.
+func blendLum(c [3]float64) float64 {
+	return 0.3*c[0] + 0.59*c[1] + 0.11*c[2]
+}
+
+// blendSetLum sets the luminosity of the color, the channels are clipped to the `0` - `1` range while keeping the luminosity.
+func blendSetLum(c [3]float64, l float64) [3]float64 {
+	d := l - blendLum(c)
+	for i := range c {
+		c[i] += d
+	}
+	l = blendLum(c)
+	n := math.Min(c[0], math.Min(c[1], c[2]))
+	x := math.Max(c[0], math.Max(c[1], c[2]))
+	for i := range c {
+		if n < 0 {
+			c[i] = l + (c[i]-l)*l/(l-n)
+		}
+		if x > 1 {
+			c[i] = l + (c[i]-l)*(1-l)/(x-l)
+		}
+	}
+	return c
+}
+
+// blendSat returns the saturation of the color for the non-separable blend modes.
+func blendSat(c [3]float64) float64 {
+	return math.Max(c[0], math.Max(c[1], c[2])) - math.Min(c[0], math.Min(c[1], c[2]))
+}
+
+// blendSetSat sets the saturation of the color while keeping the order of the channels.
+func blendSetSat(c [3]float64, s float64) [3]float64 {
+	i := []int{0, 1, 2}
+	sort.SliceStable(i, func(a, b int) bool {
+		return c[i[a]] < c[i[b]]
+	})
+	min, mid, max := i[0], i[1], i[2]
+	var v [3]float64
+	if c[max] > c[min] {
+		v[mid] = (c[mid] - c[min]) * s / (c[max] - c[min])
+		v[max] = s
+	}
+	return v
+}
+
+// blend blends the backdrop and the source color (`0` - `1`) with the blend mode.
+func blend(cb [3]float64, cs [3]float64, mode BlendMode) [3]float64 {
+	switch mode {
+	case BlendHue:
+		return blendSetLum(blendSetSat(cs, blendSat(cb)), blendLum(cb))
+	case BlendSaturation:
+		return blendSetLum(blendSetSat(cb, blendSat(cs)), blendLum(cb))
+	case BlendColor:
+		return blendSetLum(cs, blendLum(cb))
+	case BlendLuminosity:
+		return blendSetLum(cb, blendLum(cs))
+	}
+	var v [3]float64
+	for i := range v {
+		v[i] = blendChannel(cb[i], cs[i], mode)
+	}
+	return v
+}
+
+// Blend blends the top color as a layer on the current color (the backdrop) with the blend mode, like the layers of the design tools and CSS `mix-blend-mode`.
+// The blended color is composited on the backdrop with the alpha of the top color (source-over), and the result is the same as the top color if the backdrop is transparent.
+//
+// reference: https://www.w3.org/TR/compositing-1/#blending
+func (c Color) Blend(top Color, mode BlendMode) Color {
+	cb := [3]float64{c.Red / 255, c.Green / 255, c.Blue / 255}
+	cs := [3]float64{top.Red / 255, top.Green / 255, top.Blue / 255}
+	b := blend(cb, cs, mode)
+	// The blended color only applies to the area where the backdrop is opaque.
+	var v [3]float64
+	for i := range v {
+		v[i] = ((1-c.Alpha)*cs[i] + c.Alpha*b[i]) * 255
+	}
+	return Composite(newColor(v[0], v[1], v[2], top.Alpha), c, CompositeSourceOver)
+}
@@ -0,0 +1,65 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlend(t *testing.T) {
+	assert := assert.New(t)
+	cb, cs := NewHex("DB7093"), NewHex("3B82F6")
+	modes := map[BlendMode]string{
+		BlendNormal:     "3B82F6",
+		BlendMultiply:   "33398E",
+		BlendScreen:     "E3B9FB",
+		BlendOverlay:    "C872F7",
+		BlendDarken:     "3B7093",
+		BlendLighten:    "DB82F6",
+		BlendColorDodge: "FFE4FF",
+		BlendColorBurn:  "63008F",
+		BlendHardLight:  "6573F7",
+		BlendSoftLight:  "CA71BE",
+		BlendDifference: "A01263",
+		BlendExclusion:  "B1806D",
+		BlendHue:        "7099DB",
+		BlendSaturation: "FF5E93",
+		BlendColor:      "5E9BFF",
+		BlendLuminosity: "C15679",
+	}
+	for k, v := range modes {
+		assert.Equal(v, cb.Blend(cs, k).Hex(), "mode %d", k)
+	}
+
+	assert.Equal("FFFFFF", NewHex("FFF").Blend(NewHex("FFF"), BlendColorDodge).Hex())
+	assert.Equal("000000", NewHex("000").Blend(NewHex("000"), BlendColorBurn).Hex())
+	assert.Equal("808080", NewHex("808080").Blend(NewHex("FFF"), BlendMultiply).Hex())
+}
+
+func TestBlendAlpha(t *testing.T) {
+	assert := assert.New(t)
+	cs := NewHex("3B82F6")
+	c := NewHex("DB7093").Blend(NewRGBA(59, 130, 246, 0.5), BlendMultiply)
+	assert.Equal(1.0, c.Alpha)
+	assert.InDeltaSlice([]float64{134.835, 84.549, 144.406}, []float64{c.Red, c.Green, c.Blue}, 1e-3)
+
+	c = NewRGBA(219, 112, 147, 0.5).Blend(cs, BlendMultiply)
+	assert.Equal(1.0, c.Alpha)
+	assert.InDeltaSlice([]float64{54.835, 93.549, 193.906}, []float64{c.Red, c.Green, c.Blue}, 1e-3)
+
+	assert.Equal(cs, NewRGBA(0, 0, 0, 0).Blend(cs, BlendMultiply))
+	assert.Equal(NewRGBA(0, 0, 0, 0), NewRGBA(255, 0, 0, 0).Blend(NewRGBA(0, 0, 255, 0), BlendScreen))
+}
+
+func TestBlendNonSeparable(t *testing.T) {
+	assert := assert.New(t)
+	cb := [3]float64{0.8, 0.4, 0.6}
+	cs := [3]float64{0.2, 0.5, 0.9}
+	assert.InDelta(blendLum(cs), blendLum(blend(cb, cs, BlendLuminosity)), 1e-9)
+	assert.InDelta(blendLum(cb), blendLum(blend(cb, cs, BlendColor)), 1e-9)
+	assert.InDelta(blendLum(cb), blendLum(blend(cb, cs, BlendHue)), 1e-9)
+	assert.InDelta(blendSat(cs), blendSat(blend(cb, cs, BlendSaturation)), 1e-9)
+	assert.Equal([3]float64{0, 0, 0}, blendSetSat([3]float64{0.5, 0.5, 0.5}, 0.3))
+	v := blendSetSat([3]float64{0.6, 0.2, 0.4}, 0.3)
+	assert.InDeltaSlice([]float64{0.3, 0, 0.15}, v[:], 1e-9)
+}
@@ -0,0 +1,57 @@
+package noire
+
+// xkcdColors are a subset of the xkcd color survey with only the most common names (not the full 949 colors), the names are the same as the survey results.
+// Run `go generate` to replace it with the full survey list from https://xkcd.com/color/rgb.txt (see `gen_xkcd.go`).
+//
+// reference: https://xkcd.com/color/rgb/
+var xkcdColors = []namedColor{
+	{"purple", "7E1E9C"},
+	{"green", "15B01A"},
+	{"blue", "0343DF"},
+	{"pink", "FF81C0"},
+	{"brown", "653700"},
+	{"red", "E50000"},
+	{"light blue", "95D0FC"},
+	{"teal", "029386"},
+	{"orange", "F97306"},
+	{"light green", "96F97B"},
+	{"magenta", "C20078"},
+	{"yellow", "FFFF14"},
+	{"sky blue", "75BBFD"},
+	{"grey", "929591"},
+	{"lime green", "89FE05"},
+	{"light purple", "BF77F6"},
+	{"violet", "9A0EEA"},
+	{"dark green", "033500"},
+	{"turquoise", "06C2AC"},
+	{"lavender", "C79FEF"},
+	{"dark blue", "00035B"},
+	{"tan", "D1B26F"},
+	{"cyan", "00FFFF"},
+	{"aqua", "13EAC9"},
+	{"forest green", "06470C"},
+	{"mauve", "AE7181"},
+	{"dark purple", "35063E"},
+	{"bright green", "01FF07"},
+	{"maroon", "650021"},
+	{"olive", "6E750E"},
+	{"salmon", "FF796C"},
+	{"beige", "E6DAA6"},
+	{"royal blue", "0504AA"},
+	{"navy blue", "001146"},
+	{"lilac", "CEA2FD"},
+	{"black", "000000"},
+	{"hot pink", "FF028D"},
+	{"light brown", "AD8150"},
+	{"pale green", "C7FDB5"},
+	{"peach", "FFB07C"},
+	{"olive green", "677A04"},
+	{"dark pink", "CB416B"},
+	{"periwinkle", "8E82FE"},
+	{"sea green", "53FCA1"},
+	{"lime", "AAFF32"},
+	{"indigo", "380282"},
+	{"mustard", "CEB301"},
+	{"light pink", "FFD1DF"},
+	{"white", "FFFFFF"},
+}
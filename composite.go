@@ -0,0 +1,86 @@
+package noire
+
+// CompositeOperator is the Porter-Duff operator to composite a source color with a destination (backdrop) color.
+//
+// reference: https://www.w3.org/TR/compositing-1/#porterduffcompositingoperators
+type CompositeOperator int
+
+const (
+	// CompositeSourceOver places the source over the destination, it's the default of the browsers and the design tools.
+	CompositeSourceOver CompositeOperator = iota
+	// CompositeDestinationOver places the destination over the source.
+	CompositeDestinationOver
+	// CompositeSourceIn keeps the source where the destination is.
+	CompositeSourceIn
+	// CompositeDestinationIn keeps the destination where the source is.
+	CompositeDestinationIn
+	// CompositeSourceOut keeps the source where the destination isn't.
+	CompositeSourceOut
+	// CompositeDestinationOut keeps the destination where the source isn't.
+	CompositeDestinationOut
+	// CompositeSourceAtop places the source over the destination only where the destination is.
+	CompositeSourceAtop
+	// CompositeDestinationAtop places the destination over the source only where the source is.
+	CompositeDestinationAtop
+	// CompositeXor keeps the source and the destination where they don't overlap.
+	CompositeXor
+	// CompositeCopy takes the source only.
+	CompositeCopy
+	// CompositeDestination takes the destination only.
+	CompositeDestination
+	// CompositeClear clears both colors to a transparent black color.
+	CompositeClear
+	// CompositeLighter adds the source and the destination, the alpha is clamped to `1`.
+	CompositeLighter
+)
+
+// fractions returns the fractions of the source (`fa`) and the destination (`fb`) with the alpha of both colors.
+func (op CompositeOperator) fractions(as float64, ab float64) (fa float64, fb float64) {
+	switch op {
+	case CompositeSourceOver:
+		return 1, 1 - as
+	case CompositeDestinationOver:
+		return 1 - ab, 1
+	case CompositeSourceIn:
+		return ab, 0
+	case CompositeDestinationIn:
+		return 0, as
+	case CompositeSourceOut:
+		return 1 - ab, 0
+	case CompositeDestinationOut:
+		return 0, 1 - as
+	case CompositeSourceAtop:
+		return ab, 1 - as
+	case CompositeDestinationAtop:
+		return 1 - ab, as
+	case CompositeXor:
+		return 1 - ab, 1 - as
+	case CompositeCopy:
+		return 1, 0
+	case CompositeDestination:
+		return 0, 1
+	case CompositeLighter:
+		return 1, 1
+	}
+	return 0, 0
+}
+
+// Composite composites the source color with the destination (backdrop) color by the Porter-Duff operator in sRGB, the same as the browsers do.
+// It returns a transparent black color if the result is fully transparent.
+//
+// reference: https://www.w3.org/TR/compositing-1/#porterduffcompositingoperators
+func Composite(src Color, dst Color, op CompositeOperator) Color {
+	fa, fb := op.fractions(src.Alpha, dst.Alpha)
+	p := src.Premultiply().Scale(fa).Add(dst.Premultiply().Scale(fb))
+	if p.Alpha > 1 {
+		// `CompositeLighter` adds the premultiplied colors, the result is clamped by the alpha of `1`.
+		return newColor(p.Red, p.Green, p.Blue, 1)
+	}
+	return p.Unpremultiply()
+}
+
+// Flatten returns the effective color of the current color over the background color (source-over),
+// the result is opaque if the background is opaque, so the contrast of a translucent color (like a `rgba()` token) on a page can be checked.
+func (c Color) Flatten(background Color) Color {
+	return Composite(c, background, CompositeSourceOver)
+}
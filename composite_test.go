@@ -0,0 +1,50 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposite(t *testing.T) {
+	assert := assert.New(t)
+	src := NewRGBA(255, 0, 0, 0.5)
+	dst := NewRGBA(0, 0, 255, 0.5)
+	ops := map[CompositeOperator]Color{
+		CompositeSourceOver:      {170, 0, 85, 0.75},
+		CompositeDestinationOver: {85, 0, 170, 0.75},
+		CompositeSourceIn:        {255, 0, 0, 0.25},
+		CompositeDestinationIn:   {0, 0, 255, 0.25},
+		CompositeSourceOut:       {255, 0, 0, 0.25},
+		CompositeDestinationOut:  {0, 0, 255, 0.25},
+		CompositeSourceAtop:      {127.5, 0, 127.5, 0.5},
+		CompositeDestinationAtop: {127.5, 0, 127.5, 0.5},
+		CompositeXor:             {127.5, 0, 127.5, 0.5},
+		CompositeCopy:            {255, 0, 0, 0.5},
+		CompositeDestination:     {0, 0, 255, 0.5},
+		CompositeClear:           {0, 0, 0, 0},
+		CompositeLighter:         {127.5, 0, 127.5, 1},
+	}
+	for k, v := range ops {
+		c := Composite(src, dst, k)
+		assert.InDeltaSlice([]float64{v.Red, v.Green, v.Blue, v.Alpha}, []float64{c.Red, c.Green, c.Blue, c.Alpha}, 1e-9, "operator %d", k)
+	}
+
+	c := Composite(NewRGBA(255, 0, 0, 0.25), NewRGBA(0, 0, 255, 0.25), CompositeLighter)
+	assert.InDeltaSlice([]float64{127.5, 0, 127.5, 0.5}, []float64{c.Red, c.Green, c.Blue, c.Alpha}, 1e-9)
+	assert.Equal(NewRGBA(0, 0, 0, 0), Composite(NewRGBA(255, 0, 0, 0), NewRGBA(0, 0, 255, 0), CompositeSourceOver))
+	assert.Equal(NewRGBA(0, 0, 0, 0), Composite(NewRGBA(255, 0, 0, 1), NewRGBA(0, 0, 255, 1), CompositeXor))
+}
+
+func TestFlatten(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGBA(0, 0, 0, 0.5).Flatten(NewHTML("White"))
+	assert.Equal(1.0, c.Alpha)
+	assert.Equal("808080", c.Hex())
+	assert.InDelta(3.98, c.Contrast(NewHTML("White")), 0.01)
+
+	c = NewHTML("Red").Flatten(NewHTML("Blue"))
+	assert.Equal(NewHTML("Red"), c)
+	c = NewRGBA(255, 0, 0, 0).Flatten(NewHTML("Blue"))
+	assert.Equal(NewHTML("Blue"), c)
+}
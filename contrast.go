@@ -0,0 +1,81 @@
+package noire
+
+import "math"
+
+// ensureContrast searches the OKLCH lightness of the color for the closest variant that `meets` the contrast, the hue is kept and the chroma is reduced if it's outside of the sRGB gamut.
+// It returns the variant with the highest `score` and false if even black or white doesn't meet the contrast.
+func ensureContrast(c Color, meets func(Color) bool, score func(Color) float64) (Color, bool) {
+	if meets(c) {
+		return c, true
+	}
+	l, ch, h := c.OKLCH()
+	at := func(l float64) Color {
+		r, g, b := fitChroma(l, ch, h, oklchToRGB)
+		return newColor(r, g, b, c.Alpha)
+	}
+	var best Color
+	bestDistance := math.Inf(1)
+	for _, target := range []float64{0, 1} {
+		if !meets(at(target)) {
+			continue
+		}
+		lo, hi := l, target
+		for i := 0; i < 32; i++ {
+			mid := (lo + hi) / 2
+			if meets(at(mid)) {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		if d := math.Abs(hi - l); d < bestDistance {
+			best, bestDistance = at(hi), d
+		}
+	}
+	if bestDistance != math.Inf(1) {
+		return best, true
+	}
+	dark, light := at(0), at(1)
+	if score(dark) >= score(light) {
+		return dark, false
+	}
+	return light, false
+}
+
+// EnsureContrast returns the closest variant of the current color that has at least the WCAG contrast ratio (like: `4.5` or `3`) on the background color,
+// the OKLCH lightness is adjusted while the hue is kept. The current color is returned if it already meets the ratio,
+// and it returns the variant with the highest contrast and false if the ratio can't be met.
+// A translucent color is measured by its flattened color on the background (see `Flatten`), the alpha channel is kept.
+func (c Color) EnsureContrast(background Color, ratio float64) (Color, bool) {
+	return ensureContrast(c, func(v Color) bool {
+		return v.Flatten(background).Contrast(background) >= ratio
+	}, func(v Color) float64 {
+		return v.Flatten(background).Contrast(background)
+	})
+}
+
+// EnsureContrastAPCA returns the closest variant of the current color as the text that has at least the absolute APCA Lc contrast (like: `75` or `60`) on the background color,
+// the OKLCH lightness is adjusted while the hue is kept. It returns the variant with the highest contrast and false if the Lc can't be met.
+func (c Color) EnsureContrastAPCA(background Color, lc float64) (Color, bool) {
+	return ensureContrast(c, func(v Color) bool {
+		return math.Abs(v.ContrastAPCA(background)) >= lc
+	}, func(v Color) float64 {
+		return math.Abs(v.ContrastAPCA(background))
+	})
+}
+
+// BestForeground returns the candidate color with the highest WCAG contrast on the background color, the first one wins if the contrasts are the same.
+// It picks from black and white if there are no candidates, a translucent candidate is measured by its flattened color on the background (see `Flatten`).
+func BestForeground(background Color, candidates ...Color) Color {
+	if len(candidates) == 0 {
+		candidates = []Color{NewRGB(0, 0, 0), NewRGB(255, 255, 255)}
+	}
+	best := candidates[0]
+	bestContrast := best.Flatten(background).Contrast(background)
+	for _, v := range candidates[1:] {
+		if c := v.Flatten(background).Contrast(background); c > bestContrast {
+			best, bestContrast = v, c
+		}
+	}
+	return best
+}
@@ -0,0 +1,76 @@
+package noire
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureContrast(t *testing.T) {
+	assert := assert.New(t)
+	bg := NewHex("3B82F6")
+	c := NewHex("93C5FD")
+	v, ok := c.EnsureContrast(bg, 4.5)
+	assert.True(ok)
+	assert.GreaterOrEqual(v.Contrast(bg), 4.5)
+	_, _, h1 := c.OKLCH()
+	_, _, h2 := v.OKLCH()
+	assert.InDelta(h1, h2, 2)
+
+	v, ok = c.EnsureContrast(NewHex("FFF"), 4.5)
+	assert.True(ok)
+	assert.InDelta(4.5, v.Contrast(NewHex("FFF")), 1e-6)
+	assert.Equal("4A7AAD", v.Hex())
+
+	v, ok = c.EnsureContrast(NewHex("FFF"), 1)
+	assert.True(ok)
+	assert.Equal(c, v)
+
+	v, ok = c.EnsureContrast(NewHex("777"), 7)
+	assert.False(ok)
+	assert.Equal("000000", v.Hex())
+
+	// A translucent color is measured on the background.
+	white := NewHex("FFF")
+	v, ok = NewRGBA(147, 197, 253, 0.8).EnsureContrast(white, 4.5)
+	assert.True(ok)
+	assert.Equal(0.8, v.Alpha)
+	assert.InDelta(4.5, v.Flatten(white).Contrast(white), 1e-6)
+	assert.Equal("2A588A", v.Hex())
+	v, ok = NewRGBA(147, 197, 253, 0.5).EnsureContrast(white, 4.5)
+	assert.False(ok)
+	assert.Equal("000000", v.Hex())
+	assert.Less(v.Flatten(white).Contrast(white), 4.5)
+}
+
+func TestEnsureContrastAPCA(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGBA(147, 197, 253, 0.8)
+	v, ok := c.EnsureContrastAPCA(NewHex("FFF"), 75)
+	assert.True(ok)
+	assert.InDelta(75, v.ContrastAPCA(NewHex("FFF")), 0.01)
+	assert.Equal(0.8, v.Alpha)
+
+	v, ok = NewHex("93C5FD").EnsureContrastAPCA(NewHex("222"), 90)
+	assert.True(ok)
+	assert.InDelta(90, math.Abs(v.ContrastAPCA(NewHex("222"))), 0.01)
+	assert.Equal("D6E9FF", v.Hex())
+
+	_, ok = NewHex("93C5FD").EnsureContrastAPCA(NewHex("888"), 90)
+	assert.False(ok)
+}
+
+func TestBestForeground(t *testing.T) {
+	assert := assert.New(t)
+	bg := NewHex("3B82F6")
+	assert.Equal("000000", BestForeground(bg).Hex())
+	assert.Equal("FFFFFF", BestForeground(NewHex("1E3A8A")).Hex())
+	assert.Equal("DBEAFE", BestForeground(bg, NewHex("DBEAFE"), NewHex("1E3A8A")).Hex())
+	assert.Equal("FF0000", BestForeground(NewHex("FFF"), NewHex("F00"), NewRGB(255, 0, 0)).Hex())
+
+	// A translucent candidate is measured on the background.
+	faint := NewRGBA(0, 0, 0, 0.1)
+	assert.Equal("1E3A8A", BestForeground(NewHex("FFF"), faint, NewHex("1E3A8A")).Hex())
+	assert.Equal(1.0, BestForeground(NewHex("FFF"), faint, NewHex("1E3A8A")).Alpha)
+}
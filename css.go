@@ -0,0 +1,426 @@
+package noire
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// cssArg is an argument of a CSS color function with its byte offset in the input.
+type cssArg struct {
+	text string
+	pos  int
+}
+
+// value parses the argument to a number and its unit (`%`, `deg`, etc.), the `none` keyword has the `none` unit.
+func (a cssArg) value() (n float64, unit string, ok bool) {
+	if a.text == "none" {
+		return 0, "none", true
+	}
+	i := 0
+	for ; i < len(a.text); i++ {
+		c := a.text[i]
+		if c >= '0' && c <= '9' || c == '.' || c == '+' || c == '-' {
+			continue
+		}
+		if (c == 'e' || c == 'E') && i > 0 && i+1 < len(a.text) && (a.text[i+1] >= '0' && a.text[i+1] <= '9' || a.text[i+1] == '+' || a.text[i+1] == '-') {
+			continue
+		}
+		break
+	}
+	n, err := strconv.ParseFloat(a.text[:i], 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return n, a.text[i:], true
+}
+
+// number parses the argument as a number or a percentage, the `ref` is the value of `100%`.
+func (a cssArg) number(ref float64) (float64, bool) {
+	n, unit, ok := a.value()
+	if !ok {
+		return 0, false
+	}
+	switch unit {
+	case "", "none":
+		return n, true
+	case "%":
+		return n / 100 * ref, true
+	}
+	return 0, false
+}
+
+// hue parses the argument as an angle in degrees, `deg`, `rad`, `grad` and `turn` units are accepted.
+func (a cssArg) hue() (float64, bool) {
+	n, unit, ok := a.value()
+	if !ok {
+		return 0, false
+	}
+	switch unit {
+	case "", "deg", "none":
+	case "rad":
+		n = n * 180 / math.Pi
+	case "grad":
+		n = n * 0.9
+	case "turn":
+		n = n * 360
+	default:
+		return 0, false
+	}
+	n = math.Mod(n, 360)
+	if n < 0 {
+		n += 360
+	}
+	return n, true
+}
+
+// cssArgs splits the arguments of a CSS color function, either separated by commas (the legacy syntax) or whitespaces with a `/` before the alpha channel.
+func cssArgs(s string, offset int) (args []cssArg, alpha *cssArg, legacy bool, errPos int) {
+	legacy = strings.IndexByte(s, ',') != -1
+	slash := false
+	expectValue := true
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case isSpace(c):
+			i++
+		case c == ',':
+			if !legacy || expectValue || slash {
+				return nil, nil, false, offset + i
+			}
+			expectValue = true
+			i++
+		case c == '/':
+			if legacy || slash || len(args) == 0 {
+				return nil, nil, false, offset + i
+			}
+			slash = true
+			expectValue = true
+			i++
+		default:
+			if legacy && !expectValue {
+				return nil, nil, false, offset + i
+			}
+			j := i
+			for j < len(s) && !isSpace(s[j]) && s[j] != ',' && s[j] != '/' {
+				j++
+			}
+			arg := cssArg{text: s[i:j], pos: offset + i}
+			if slash {
+				if alpha != nil {
+					return nil, nil, false, offset + i
+				}
+				alpha = &arg
+			} else {
+				args = append(args, arg)
+			}
+			expectValue = false
+			i = j
+		}
+	}
+	if expectValue && (legacy || slash) {
+		return nil, nil, false, offset + len(s)
+	}
+	return args, alpha, legacy, -1
+}
+
+// isSpace returns true if the character is a CSS whitespace.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// Parse initializes a color based on a CSS Color Module Level 4 string, it accepts Hex strings (`#rgb`, `#rgba`, `#rrggbb`, `#rrggbbaa`),
+// the HTML color names, `transparent` and the `rgb()`, `rgba()`, `hsl()`, `hsla()`, `hwb()`, `lab()`, `lch()`, `oklab()`, `oklch()` and `color()` functions.
+// The colors outside of the sRGB gamut are clipped.
+//
+// reference: https://www.w3.org/TR/css-color-4/
+func Parse(color string) (Color, error) {
+	start := 0
+	end := len(color)
+	for start < end && isSpace(color[start]) {
+		start++
+	}
+	for end > start && isSpace(color[end-1]) {
+		end--
+	}
+	if start == end {
+		return Color{}, &ParseError{Func: "Parse", Input: color, Pos: start, Err: ErrInvalidSyntax}
+	}
+	s := strings.ToLower(color[start:end])
+
+	if s[0] == '#' {
+		r, g, b, a, err := parseHexAlpha("Parse", s, true)
+		if err != nil {
+			perr := err.(*ParseError)
+			perr.Input = color
+			perr.Pos += start
+			return Color{}, perr
+		}
+		return newColor(r, g, b, a), nil
+	}
+
+	paren := strings.IndexByte(s, '(')
+	if paren == -1 {
+		if s == "transparent" {
+			return newColor(0, 0, 0, 0), nil
+		}
+		v, ok := cssPalette.lookupExact(s)
+		if !ok {
+			return Color{}, &ParseError{Func: "Parse", Input: color, Pos: start, Err: ErrUnknownName}
+		}
+		r, g, b := HexToRGB(v)
+		return newColor(r, g, b, 1), nil
+	}
+	if s[len(s)-1] != ')' {
+		return Color{}, &ParseError{Func: "Parse", Input: color, Pos: end, Err: ErrInvalidSyntax}
+	}
+	fail := func(pos int) (Color, error) {
+		return Color{}, &ParseError{Func: "Parse", Input: color, Pos: pos, Err: ErrInvalidSyntax}
+	}
+
+	fn := s[:paren]
+	args, alpha, legacy, errPos := cssArgs(s[paren+1:len(s)-1], start+paren+1)
+	if errPos != -1 {
+		return fail(errPos)
+	}
+	if legacy && fn != "rgb" && fn != "rgba" && fn != "hsl" && fn != "hsla" {
+		return fail(start + paren + 1)
+	}
+	if legacy && len(args) == 4 {
+		alpha = &args[3]
+		args = args[:3]
+	}
+
+	space := ""
+	if fn == "color" {
+		if len(args) == 0 {
+			return fail(end - 1)
+		}
+		space = args[0].text
+		args = args[1:]
+		switch space {
+		case "srgb", "srgb-linear", "xyz", "xyz-d50", "xyz-d65":
+		default:
+			return fail(start + paren + 1)
+		}
+	}
+	if len(args) != 3 {
+		return fail(end - 1)
+	}
+
+	a := 1.0
+	if alpha != nil {
+		v, ok := alpha.number(1)
+		if !ok {
+			return fail(alpha.pos)
+		}
+		a = v
+	}
+
+	var v [3]float64
+	parse := func(i int, ref float64) bool {
+		n, ok := args[i].number(ref)
+		v[i] = n
+		return ok
+	}
+	parseHue := func(i int) bool {
+		n, ok := args[i].hue()
+		v[i] = n
+		return ok
+	}
+
+	var ok bool
+	switch fn {
+	case "rgb", "rgba":
+		ok = parse(0, 255) && parse(1, 255) && parse(2, 255)
+	case "hsl", "hsla", "hwb":
+		ok = parseHue(0) && parse(1, 100) && parse(2, 100)
+	case "lab":
+		ok = parse(0, 100) && parse(1, 125) && parse(2, 125)
+	case "lch":
+		ok = parse(0, 100) && parse(1, 150) && parseHue(2)
+	case "oklab":
+		ok = parse(0, 1) && parse(1, 0.4) && parse(2, 0.4)
+	case "oklch":
+		ok = parse(0, 1) && parse(1, 0.4) && parseHue(2)
+	case "color":
+		ok = parse(0, 1) && parse(1, 1) && parse(2, 1)
+	default:
+		return fail(start)
+	}
+	if !ok {
+		for _, arg := range args {
+			if _, _, valid := arg.value(); !valid {
+				return fail(arg.pos)
+			}
+		}
+		return fail(start + paren + 1)
+	}
+
+	var r, g, b float64
+	switch fn {
+	case "rgb", "rgba":
+		r, g, b = v[0], v[1], v[2]
+	case "hsl", "hsla":
+		r, g, b = HSLToRGBExact(v[0], math.Max(0, v[1]), math.Max(0, math.Min(100, v[2])))
+	case "hwb":
+		w, k := math.Max(0, v[1]), math.Max(0, v[2])
+		if w+k >= 100 {
+			gray := w / (w + k) * 255
+			r, g, b = gray, gray, gray
+		} else {
+			val := 100 - k
+			r, g, b = HSVToRGBExact(v[0], 100-w/val*100, val)
+		}
+	case "lab", "lch":
+		l, x, y := math.Max(0, math.Min(100, v[0])), v[1], v[2]
+		if fn == "lch" {
+			x, y = polarToRectangular(math.Max(0, v[1]), v[2])
+		}
+		// CSS `lab()` and `lch()` are relative to D50 (unlike `NewLab` and `NewLCh`), `AdaptXYZ` uses the CSS D50 to D65 matrix.
+		r, g, b = LabToRGB(l, x, y, D50)
+	case "oklab", "oklch":
+		l, x, y := math.Max(0, math.Min(1, v[0])), v[1], v[2]
+		if fn == "oklch" {
+			x, y = polarToRectangular(math.Max(0, v[1]), v[2])
+		}
+		r, g, b = linearToRGB(okLabToLinear(l, x, y))
+	case "color":
+		switch space {
+		case "srgb":
+			r, g, b = v[0]*255, v[1]*255, v[2]*255
+		case "srgb-linear":
+			r, g, b = linearToRGB(v[0], v[1], v[2])
+		case "xyz", "xyz-d65":
+			r, g, b = linearToRGB(xyzToLinearRGBMatrix.mul(v[0], v[1], v[2]))
+		case "xyz-d50":
+			r, g, b = linearToRGB(xyzToLinearRGBMatrix.mul(d50ToD65Matrix.mul(v[0], v[1], v[2])))
+		}
+	}
+	return newColor(r, g, b, a), nil
+}
+
+// Style is the CSS syntax used by `Color.Format` to serialize a color.
+type Style int
+
+const (
+	// StyleHex formats the color as the shortest `#` prefixed Hex string, like: `#F00`, `#F008`, `#DB7093` or `#DB709380`.
+	StyleHex Style = iota
+	// StyleRGB formats the color with the modern `rgb()` syntax, like: `rgb(219 112 147 / 50%)`.
+	StyleRGB
+	// StyleRGBLegacy formats the color with the legacy comma separated syntax, like: `rgb(219, 112, 147)` or `rgba(219, 112, 147, 0.5)`.
+	StyleRGBLegacy
+	// StyleHSL formats the color with the modern `hsl()` syntax, like: `hsl(340.37 59.78% 64.9% / 50%)`.
+	StyleHSL
+	// StyleHSLLegacy formats the color with the legacy comma separated syntax, like: `hsl(340.37, 59.78%, 64.9%)` or `hsla(340.37, 59.78%, 64.9%, 0.5)`.
+	StyleHSLLegacy
+	// StyleHWB formats the color with the `hwb()` syntax, like: `hwb(340.37 43.92% 14.12% / 50%)`.
+	StyleHWB
+	// StyleOKLCH formats the color with the `oklch()` syntax, like: `oklch(67.79% 0.1382 0.68 / 50%)`.
+	StyleOKLCH
+)
+
+// formatNumber formats the number with at most `precision` decimal places, the trailing zeros are removed.
+func formatNumber(v float64, precision int) string {
+	p := math.Pow(10, float64(precision))
+	v = math.Round(v*p) / p
+	if v == 0 {
+		v = 0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatAlpha formats the alpha channel (`0` - `1`) multiplied by the scale with at least `minPrecision` decimal places,
+// more decimal places are used if a translucent color would be rounded to fully transparent or fully opaque.
+func formatAlpha(a float64, scale float64, precision int, minPrecision int) string {
+	if precision < minPrecision {
+		precision = minPrecision
+	}
+	for a > 0 && a < 1 && precision < 15 {
+		p := math.Pow(10, float64(precision))
+		if v := math.Round(a*scale*p) / p; v != 0 && v != scale {
+			break
+		}
+		precision++
+	}
+	return formatNumber(a*scale, precision)
+}
+
+// Format returns a CSS string of the current color in the specified style with at most 2 decimal places.
+func (c Color) Format(style Style) string {
+	return c.FormatPrecision(style, 2)
+}
+
+// FormatPrecision returns a CSS string of the current color in the specified style with at most `precision` decimal places,
+// the alpha channel is omitted if the color is opaque. The chroma of `StyleOKLCH` comes with 2 extra decimal places since it ranges from `0` to about `0.4`.
+// The alpha channel comes with at least 3 decimal places (`0.004` or `0.4%`), and a translucent color is never rounded to fully transparent or fully opaque.
+// A negative precision is the same as `0`, and the channels out of the sRGB gamut (like: a `Color` literal with `Red: 260`) are clamped so the string is always valid.
+func (c Color) FormatPrecision(style Style, precision int) string {
+	if precision < 0 {
+		precision = 0
+	}
+	c = newColor(c.Red, c.Green, c.Blue, c.Alpha)
+	alpha := func(sep string) string {
+		if c.Alpha == 1 {
+			return ""
+		}
+		return sep + formatAlpha(c.Alpha, 100, precision, 1) + "%"
+	}
+	switch style {
+	case StyleRGB:
+		return "rgb(" + formatNumber(c.Red, precision) + " " + formatNumber(c.Green, precision) + " " + formatNumber(c.Blue, precision) + alpha(" / ") + ")"
+	case StyleRGBLegacy:
+		rgb := formatNumber(c.Red, precision) + ", " + formatNumber(c.Green, precision) + ", " + formatNumber(c.Blue, precision)
+		if c.Alpha == 1 {
+			return "rgb(" + rgb + ")"
+		}
+		return "rgba(" + rgb + ", " + formatAlpha(c.Alpha, 1, precision, 3) + ")"
+	case StyleHSL:
+		h, s, l := c.HSLExact()
+		return "hsl(" + formatNumber(h, precision) + " " + formatNumber(s, precision) + "% " + formatNumber(l, precision) + "%" + alpha(" / ") + ")"
+	case StyleHSLLegacy:
+		h, s, l := c.HSLExact()
+		hsl := formatNumber(h, precision) + ", " + formatNumber(s, precision) + "%, " + formatNumber(l, precision) + "%"
+		if c.Alpha == 1 {
+			return "hsl(" + hsl + ")"
+		}
+		return "hsla(" + hsl + ", " + formatAlpha(c.Alpha, 1, precision, 3) + ")"
+	case StyleHWB:
+		h, s, v := c.HSVExact()
+		w := (100 - s) * v / 100
+		b := 100 - v
+		return "hwb(" + formatNumber(h, precision) + " " + formatNumber(w, precision) + "% " + formatNumber(b, precision) + "%" + alpha(" / ") + ")"
+	case StyleOKLCH:
+		l, ch, h := c.OKLCH()
+		if ch < 1e-6 {
+			ch, h = 0, 0
+		}
+		return "oklch(" + formatNumber(l*100, precision) + "% " + formatNumber(ch, precision+2) + " " + formatNumber(h, precision) + alpha(" / ") + ")"
+	}
+	v := []byte{uint8(math.Round(c.Red)), uint8(math.Round(c.Green)), uint8(math.Round(c.Blue)), uint8(math.Round(c.Alpha * 255))}
+	// A translucent color is never rounded to fully transparent or fully opaque.
+	if c.Alpha > 0 && v[3] == 0 {
+		v[3] = 1
+	} else if c.Alpha < 1 && v[3] == 255 {
+		v[3] = 254
+	}
+	if v[3] == 255 {
+		v = v[:3]
+	}
+	short := true
+	for _, b := range v {
+		if b>>4 != b&0xF {
+			short = false
+		}
+	}
+	const digits = "0123456789ABCDEF"
+	buf := []byte{'#'}
+	for _, b := range v {
+		if short {
+			buf = append(buf, digits[b&0xF])
+		} else {
+			buf = append(buf, digits[b>>4], digits[b&0xF])
+		}
+	}
+	return string(buf)
+}
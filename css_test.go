@@ -0,0 +1,155 @@
+package noire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		input string
+		hex   string
+		alpha float64
+	}{
+		{"#f00", "FF0000", 1},
+		{"#f008", "FF0000", 0.5333333333333333},
+		{"#DB7093", "DB7093", 1},
+		{"#db709380", "DB7093", 0.5019607843137255},
+		{"  PaleVioletRed ", "DB7093", 1},
+		{"transparent", "000000", 0},
+		{"rgb(219, 112, 147)", "DB7093", 1},
+		{"rgba(219, 112, 147, 0.5)", "DB7093", 0.5},
+		{"rgb(219 112 147 / 50%)", "DB7093", 0.5},
+		{"RGB(100% 0% 50%)", "FF0080", 1},
+		{"rgb(none 255 0)", "00FF00", 1},
+		{"hsl(340, 59.8%, 64.9%)", "DB7094", 1},
+		{"hsla(340deg 59.8% 64.9% / .25)", "DB7094", 0.25},
+		{"hsl(0.5turn 100% 50%)", "00FFFF", 1},
+		{"hsl(3.14159265rad 100% 50%)", "00FFFF", 1},
+		{"hsl(200grad 100% 50%)", "00FFFF", 1},
+		{"hsl(-240 100% 50%)", "00FF00", 1},
+		{"hwb(0 0% 0%)", "FF0000", 1},
+		{"hwb(120 20% 30%)", "33B333", 1},
+		{"hwb(0 60% 60%)", "808080", 1},
+		{"lab(54.29 80.81 69.89)", "FF0000", 1},
+		{"lab(100% 0 0)", "FFFFFF", 1},
+		{"lch(54.29 106.84 40.85)", "FF0000", 1},
+		{"oklab(0.628 0.2249 0.1258)", "FF0000", 1},
+		{"oklch(62.8% 0.2577 29.23)", "FF0000", 1},
+		{"oklch(0 0 0 / 0.1)", "000000", 0.1},
+		{"color(srgb 1 0.5 0)", "FF8000", 1},
+		{"color(srgb-linear 0.2159 0.2159 0.2159)", "808080", 1},
+		{"color(xyz-d65 0.9505 1 1.0891)", "FFFFFF", 1},
+		{"color(xyz-d50 0.9642 1 0.8252)", "FFFFFF", 1},
+	}
+	for _, v := range tests {
+		c, err := Parse(v.input)
+		assert.NoError(err, v.input)
+		assert.Equal(v.hex, c.Hex(), v.input)
+		assert.InDelta(v.alpha, c.Alpha, 1e-9, v.input)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		input string
+		err   error
+		pos   int
+	}{
+		{"", ErrInvalidSyntax, 0},
+		{"#ff", ErrInvalidLength, 3},
+		{" #ffz", ErrInvalidDigit, 4},
+		{"NinjaTurtle", ErrUnknownName, 0},
+		{"pale violet red", ErrUnknownName, 0},
+		{"PALE\tVIOLETRED", ErrUnknownName, 0},
+		{"rgb(1, 2, 3", ErrInvalidSyntax, 11},
+		{"rgb(1, 2 3)", ErrInvalidSyntax, 9},
+		{"rgb(1 2 3 / 0.5 / 1)", ErrInvalidSyntax, 16},
+		{"rgb(1 2 foo)", ErrInvalidSyntax, 8},
+		{"rgb(1 2)", ErrInvalidSyntax, 7},
+		{"rgb(1, 2, 3,)", ErrInvalidSyntax, 12},
+		{"lab(1, 2, 3)", ErrInvalidSyntax, 4},
+		{"hsl(1px 2% 3%)", ErrInvalidSyntax, 4},
+		{"color(p3 1 0 0)", ErrInvalidSyntax, 6},
+		{"foo(1 2 3)", ErrInvalidSyntax, 0},
+	}
+	for _, v := range tests {
+		_, err := Parse(v.input)
+		assert.True(errors.Is(err, v.err), v.input)
+		var perr *ParseError
+		if assert.True(errors.As(err, &perr), v.input) {
+			assert.Equal(v.pos, perr.Pos, v.input)
+			assert.Equal(v.input, perr.Input, v.input)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	assert.Equal("#DB7093", c.Format(StyleHex))
+	assert.Equal("rgb(219 112 147)", c.Format(StyleRGB))
+	assert.Equal("rgb(219, 112, 147)", c.Format(StyleRGBLegacy))
+	assert.Equal("hsl(340.37 59.78% 64.9%)", c.Format(StyleHSL))
+	assert.Equal("hsl(340.37, 59.78%, 64.9%)", c.Format(StyleHSLLegacy))
+	assert.Equal("hwb(340.37 43.92% 14.12%)", c.Format(StyleHWB))
+	assert.Equal("oklch(67.79% 0.1382 0.68)", c.Format(StyleOKLCH))
+
+	c = NewRGBA(255, 0, 0, 0.5)
+	assert.Equal("#FF000080", c.Format(StyleHex))
+	assert.Equal("rgb(255 0 0 / 50%)", c.Format(StyleRGB))
+	assert.Equal("rgba(255, 0, 0, 0.5)", c.Format(StyleRGBLegacy))
+	assert.Equal("hsl(0 100% 50% / 50%)", c.Format(StyleHSL))
+	assert.Equal("hsla(0, 100%, 50%, 0.5)", c.Format(StyleHSLLegacy))
+	assert.Equal("hwb(0 0% 0% / 50%)", c.Format(StyleHWB))
+
+	assert.Equal("#F00", NewRGB(255, 0, 0).Format(StyleHex))
+	assert.Equal("#F008", NewRGBA(255, 0, 0, 0.5333).Format(StyleHex))
+	assert.Equal("oklch(0% 0 0)", NewRGB(0, 0, 0).Format(StyleOKLCH))
+}
+
+func TestFormatPrecision(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGBA(127.4567, 0, 0, 0.33333)
+	assert.Equal("rgb(127.457 0 0 / 33.333%)", c.FormatPrecision(StyleRGB, 3))
+	assert.Equal("rgba(127, 0, 0, 0.333)", c.FormatPrecision(StyleRGBLegacy, 0))
+	assert.Equal("hsla(0, 100%, 24.9915%, 0.3333)", c.FormatPrecision(StyleHSLLegacy, 4))
+	assert.Equal("rgb(127 0 0 / 33.3%)", c.FormatPrecision(StyleRGB, 0))
+
+	// A translucent color is never rounded to fully transparent or fully opaque.
+	c = NewRGBA(10, 20, 30, 0.004)
+	assert.Equal("rgba(10, 20, 30, 0.004)", c.Format(StyleRGBLegacy))
+	assert.Equal("rgb(10 20 30 / 0.4%)", c.FormatPrecision(StyleRGB, 0))
+	assert.Equal("#0A141E01", c.Format(StyleHex))
+	assert.Equal("rgba(10, 20, 30, 0.0004)", NewRGBA(10, 20, 30, 0.0004).Format(StyleRGBLegacy))
+	c = NewRGBA(10, 20, 30, 0.9999)
+	assert.Equal("rgba(10, 20, 30, 0.9999)", c.Format(StyleRGBLegacy))
+	assert.Equal("rgb(10 20 30 / 99.99%)", c.Format(StyleRGB))
+	assert.Equal("#0A141EFE", c.Format(StyleHex))
+	assert.Equal("rgba(10, 20, 30, 0)", NewRGBA(10, 20, 30, 0).Format(StyleRGBLegacy))
+	assert.Equal("#DB7093", NewRGB(219, 112, 147).FormatPrecision(StyleHex, 5))
+
+	// A negative precision is the same as `0`, and the channels are clamped.
+	assert.Equal("rgb(219 112 147)", NewRGB(219.4, 112, 147).FormatPrecision(StyleRGB, -2))
+	c = Color{Red: 260, Green: -5, Blue: 0, Alpha: 1.5}
+	assert.Equal("rgb(255 0 0)", c.FormatPrecision(StyleRGB, 2))
+	assert.Equal("#F00", c.FormatPrecision(StyleHex, 2))
+	assert.Equal("hsl(0 100% 50%)", c.FormatPrecision(StyleHSL, 2))
+	c.Alpha = 0.5
+	assert.Equal("rgba(255, 0, 0, 0.5)", c.FormatPrecision(StyleRGBLegacy, 2))
+}
+
+func TestFormatParse(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGBA(219, 112, 147, 0.5)
+	for _, style := range []Style{StyleHex, StyleRGB, StyleRGBLegacy, StyleHSL, StyleHSLLegacy, StyleHWB, StyleOKLCH} {
+		v, err := Parse(c.FormatPrecision(style, 4))
+		assert.NoError(err)
+		assert.Equal(c.Hex(), v.Hex(), c.FormatPrecision(style, 4))
+		assert.InDelta(c.Alpha, v.Alpha, 0.01)
+	}
+}
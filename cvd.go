@@ -0,0 +1,111 @@
+package noire
+
+import (
+	"image"
+	"image/color"
+)
+
+// CVD is a kind of color vision deficiency (color blindness).
+type CVD int
+
+const (
+	// Protanopia is the lack of the red (L) cones.
+	Protanopia CVD = iota
+	// Protanomaly is the anomalous red (L) cones, it's a milder Protanopia.
+	Protanomaly
+	// Deuteranopia is the lack of the green (M) cones, it's the most common color vision deficiency.
+	Deuteranopia
+	// Deuteranomaly is the anomalous green (M) cones, it's a milder Deuteranopia.
+	Deuteranomaly
+	// Tritanopia is the lack of the blue (S) cones.
+	Tritanopia
+	// Tritanomaly is the anomalous blue (S) cones, it's a milder Tritanopia.
+	Tritanomaly
+	// Achromatopsia is the total color blindness, only the luminance can be seen.
+	Achromatopsia
+	// Achromatomaly is the partial color blindness, it's a milder Achromatopsia.
+	Achromatomaly
+)
+
+// cvdMatrices are the matrices of the dichromacy with the severity of `1` in linear RGB, based on Machado et al. (2009).
+//
+// reference: https://www.inf.ufrgs.br/~oliveira/pubs_files/CVD_Simulation/CVD_Simulation.html
+var cvdMatrices = map[CVD]matrix3{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// cvdAnomalies maps the anomalous trichromacy to the dichromacy of the same cones.
+var cvdAnomalies = map[CVD]CVD{
+	Protanomaly:   Protanopia,
+	Deuteranomaly: Deuteranopia,
+	Tritanomaly:   Tritanopia,
+	Achromatomaly: Achromatopsia,
+}
+
+// SimulateCVD returns the current color as seen by people with the color vision deficiency, the color is converted in linear RGB.
+// The severity (from `0` as the normal vision to `1`) only applies to the anomalous kinds (`Protanomaly`, `Deuteranomaly`, `Tritanomaly` and `Achromatomaly`),
+// it's ignored for the complete deficiencies (like: `Protanopia`) which are always the same as the anomalous kinds with the severity of `1`.
+// The anomalous trichromacy is approximated by interpolating linearly between the normal vision and the dichromacy (the Machado matrix of the severity `1`),
+// instead of the per-severity matrices of Machado et al., so a middle severity is slightly different from the paper.
+func (c Color) SimulateCVD(kind CVD, severity float64) Color {
+	switch kind {
+	case Protanopia, Deuteranopia, Tritanopia, Achromatopsia:
+		severity = 1
+	case Protanomaly, Deuteranomaly, Tritanomaly, Achromatomaly:
+		kind = cvdAnomalies[kind]
+	default:
+		return c
+	}
+	if severity < 0 {
+		severity = 0
+	} else if severity > 1 {
+		severity = 1
+	}
+	r, g, b := rgbToLinear(c.Red, c.Green, c.Blue)
+	var sr, sg, sb float64
+	if kind == Achromatopsia {
+		y := c.RelativeLuminance()
+		sr, sg, sb = y, y, y
+	} else {
+		sr, sg, sb = cvdMatrices[kind].mul(r, g, b)
+	}
+	r, g, b = linearToRGB(r+(sr-r)*severity, g+(sg-g)*severity, b+(sb-b)*severity)
+	return newColor(r, g, b, c.Alpha)
+}
+
+// cvdImage is an image with the simulated color vision deficiency.
+type cvdImage struct {
+	image.Image
+	kind     CVD
+	severity float64
+}
+
+// ColorModel returns the color model of the image.
+func (m cvdImage) ColorModel() color.Model {
+	return color.NRGBA64Model
+}
+
+// At returns the simulated color of the pixel.
+func (m cvdImage) At(x int, y int) color.Color {
+	return FromStdColor(m.Image.At(x, y)).SimulateCVD(m.kind, m.severity).StdColor()
+}
+
+// SimulateCVDImage returns an image that applies `SimulateCVD` to every pixel of the image, the pixels are converted when they are read,
+// use `draw.Draw` to render it to an image like `image.NRGBA` if it's read multiple times.
+func SimulateCVDImage(img image.Image, kind CVD, severity float64) image.Image {
+	return cvdImage{Image: img, kind: kind, severity: severity}
+}
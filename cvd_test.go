@@ -0,0 +1,51 @@
+package noire
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulateCVD(t *testing.T) {
+	assert := assert.New(t)
+	red := NewHTML("Red")
+	assert.Equal("6D5F00", red.SimulateCVD(Protanopia, 0).Hex())
+	assert.Equal("A39000", red.SimulateCVD(Deuteranopia, 1).Hex())
+	assert.Equal("FF000F", red.SimulateCVD(Tritanopia, 1).Hex())
+	assert.Equal("7F7F7F", red.SimulateCVD(Achromatopsia, 1).Hex())
+
+	assert.Equal("C84400", red.SimulateCVD(Protanomaly, 0.5).Hex())
+	assert.Equal("D86900", red.SimulateCVD(Deuteranomaly, 0.5).Hex())
+	assert.Equal("FF0008", red.SimulateCVD(Tritanomaly, 0.5).Hex())
+	assert.Equal("CC5C5C", red.SimulateCVD(Achromatomaly, 0.5).Hex())
+	assert.Equal("FF0000", red.SimulateCVD(Protanomaly, 0).Hex())
+	assert.Equal(red.SimulateCVD(Protanopia, 1), red.SimulateCVD(Protanomaly, 2))
+
+	// The anomalous kinds with the severity of `1` are the complete deficiencies, and the severity is ignored for the complete ones.
+	c := NewHex("3B82F6")
+	for anomaly, dichromacy := range map[CVD]CVD{Protanomaly: Protanopia, Deuteranomaly: Deuteranopia, Tritanomaly: Tritanopia, Achromatomaly: Achromatopsia} {
+		assert.Equal(c.SimulateCVD(dichromacy, 1), c.SimulateCVD(anomaly, 1))
+		assert.Equal(c.SimulateCVD(dichromacy, 1), c.SimulateCVD(dichromacy, 0.3))
+	}
+
+	// The neutral colors are not affected.
+	for k := Protanopia; k <= Achromatomaly; k++ {
+		assert.Equal("FFFFFF", NewHTML("White").SimulateCVD(k, 1).Hex())
+	}
+	assert.Equal(0.5, NewRGBA(255, 0, 0, 0.5).SimulateCVD(Deuteranopia, 1).Alpha)
+	assert.Equal(red, red.SimulateCVD(CVD(-1), 1))
+}
+
+func TestSimulateCVDImage(t *testing.T) {
+	assert := assert.New(t)
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{255, 0, 0, 255})
+	img.Set(1, 0, color.NRGBA{0, 0, 255, 128})
+	m := SimulateCVDImage(img, Deuteranopia, 1)
+	assert.Equal(img.Bounds(), m.Bounds())
+	assert.Equal(color.NRGBA64Model, m.ColorModel())
+	assert.Equal("A39000", FromStdColor(m.At(0, 0)).Hex())
+	assert.Equal(NewRGBA(0, 0, 255, 128.0/255).SimulateCVD(Deuteranopia, 1).Hex(), FromStdColor(m.At(1, 0)).Hex())
+}
@@ -0,0 +1,151 @@
+package noire
+
+import "math"
+
+// deltaE76 returns the CIE76 color difference of two CIELAB colors.
+func deltaE76(l1 float64, a1 float64, b1 float64, l2 float64, a2 float64, b2 float64) float64 {
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+// deltaE94 returns the CIE94 color difference of two CIELAB colors with the graphic arts weighting factors.
+//
+// reference: http://www.brucelindbloom.com/index.html?Eqn_DeltaE_CIE94.html
+func deltaE94(l1 float64, a1 float64, b1 float64, l2 float64, a2 float64, b2 float64) float64 {
+	c1 := math.Sqrt(a1*a1 + b1*b1)
+	c2 := math.Sqrt(a2*a2 + b2*b2)
+	dl := l1 - l2
+	dc := c1 - c2
+	da := a1 - a2
+	db := b1 - b2
+	dh2 := da*da + db*db - dc*dc
+	if dh2 < 0 {
+		dh2 = 0
+	}
+	sc := 1 + 0.045*c1
+	sh := 1 + 0.015*c1
+	return math.Sqrt(dl*dl + (dc/sc)*(dc/sc) + dh2/(sh*sh))
+}
+
+// deltaE2000 returns the CIEDE2000 color difference of two CIELAB colors.
+//
+// reference: http://www2.ece.rochester.edu/~gsharma/ciede2000/ciede2000noteCRNA.pdf
+func deltaE2000(l1 float64, a1 float64, b1 float64, l2 float64, a2 float64, b2 float64) float64 {
+	rad := math.Pi / 180
+	c1 := math.Sqrt(a1*a1 + b1*b1)
+	c2 := math.Sqrt(a2*a2 + b2*b2)
+	cm := math.Pow((c1+c2)/2, 7)
+	g := 0.5 * (1 - math.Sqrt(cm/(cm+math.Pow(25, 7))))
+	a1p := a1 * (1 + g)
+	a2p := a2 * (1 + g)
+	c1p := math.Sqrt(a1p*a1p + b1*b1)
+	c2p := math.Sqrt(a2p*a2p + b2*b2)
+	h1p := hueAngle(a1p, b1)
+	h2p := hueAngle(a2p, b2)
+
+	dl := l2 - l1
+	dc := c2p - c1p
+	var dh float64
+	if c1p*c2p != 0 {
+		dh = h2p - h1p
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(c1p*c2p) * math.Sin(dh/2*rad)
+
+	lm := (l1 + l2) / 2
+	cmp := (c1p + c2p) / 2
+	hm := h1p + h2p
+	if c1p*c2p != 0 {
+		if math.Abs(h1p-h2p) <= 180 {
+			hm /= 2
+		} else if hm < 360 {
+			hm = (hm + 360) / 2
+		} else {
+			hm = (hm - 360) / 2
+		}
+	}
+	t := 1 - 0.17*math.Cos((hm-30)*rad) + 0.24*math.Cos(2*hm*rad) + 0.32*math.Cos((3*hm+6)*rad) - 0.20*math.Cos((4*hm-63)*rad)
+	dTheta := 30 * math.Exp(-((hm-275)/25)*((hm-275)/25))
+	cm7 := math.Pow(cmp, 7)
+	rc := 2 * math.Sqrt(cm7/(cm7+math.Pow(25, 7)))
+	sl := 1 + 0.015*(lm-50)*(lm-50)/math.Sqrt(20+(lm-50)*(lm-50))
+	sc := 1 + 0.045*cmp
+	sh := 1 + 0.015*cmp*t
+	rt := -math.Sin(2*dTheta*rad) * rc
+
+	return math.Sqrt((dl/sl)*(dl/sl) + (dc/sc)*(dc/sc) + (dH/sh)*(dH/sh) + rt*(dc/sc)*(dH/sh))
+}
+
+// deltaECMC returns the CMC l:c color difference of two CIELAB colors, the first color is the reference.
+//
+// reference: http://www.brucelindbloom.com/index.html?Eqn_DeltaE_CMC.html
+func deltaECMC(l1 float64, a1 float64, b1 float64, l2 float64, a2 float64, b2 float64, lightness float64, chroma float64) float64 {
+	c1 := math.Sqrt(a1*a1 + b1*b1)
+	c2 := math.Sqrt(a2*a2 + b2*b2)
+	dl := l1 - l2
+	dc := c1 - c2
+	da := a1 - a2
+	db := b1 - b2
+	dh2 := da*da + db*db - dc*dc
+	if dh2 < 0 {
+		dh2 = 0
+	}
+	h1 := hueAngle(a1, b1)
+	var t float64
+	if h1 >= 164 && h1 <= 345 {
+		t = 0.56 + math.Abs(0.2*math.Cos((h1+168)*math.Pi/180))
+	} else {
+		t = 0.36 + math.Abs(0.4*math.Cos((h1+35)*math.Pi/180))
+	}
+	c14 := c1 * c1 * c1 * c1
+	f := math.Sqrt(c14 / (c14 + 1900))
+	sl := 0.511
+	if l1 >= 16 {
+		sl = 0.040975 * l1 / (1 + 0.01765*l1)
+	}
+	sc := 0.0638*c1/(1+0.0131*c1) + 0.638
+	sh := sc * (f*t + 1 - f)
+	return math.Sqrt((dl/(lightness*sl))*(dl/(lightness*sl)) + (dc/(chroma*sc))*(dc/(chroma*sc)) + dh2/(sh*sh))
+}
+
+// hueAngle returns the hue angle (`0` - `360`) of the `a` and `b` axes.
+func hueAngle(a float64, b float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	_, h := rectangularToPolar(a, b)
+	return h
+}
+
+// DeltaE76 returns the CIE76 color difference between the current color and the specified color, it's the Euclidean distance in CIELAB (D65).
+func (c Color) DeltaE76(color Color) float64 {
+	l1, a1, b1 := c.Lab()
+	l2, a2, b2 := color.Lab()
+	return deltaE76(l1, a1, b1, l2, a2, b2)
+}
+
+// DeltaE94 returns the CIE94 color difference between the current color (as the reference) and the specified color with the graphic arts weighting factors.
+func (c Color) DeltaE94(color Color) float64 {
+	l1, a1, b1 := c.Lab()
+	l2, a2, b2 := color.Lab()
+	return deltaE94(l1, a1, b1, l2, a2, b2)
+}
+
+// DeltaE2000 returns the CIEDE2000 color difference between the current color and the specified color,
+// a difference lower than `1` is generally not perceptible by human eyes.
+func (c Color) DeltaE2000(color Color) float64 {
+	l1, a1, b1 := c.Lab()
+	l2, a2, b2 := color.Lab()
+	return deltaE2000(l1, a1, b1, l2, a2, b2)
+}
+
+// DeltaECMC returns the CMC l:c color difference between the current color (as the reference) and the specified color,
+// commonly used with `2:1` for acceptability and `1:1` for imperceptibility.
+func (c Color) DeltaECMC(color Color, lightness float64, chroma float64) float64 {
+	l1, a1, b1 := c.Lab()
+	l2, a2, b2 := color.Lab()
+	return deltaECMC(l1, a1, b1, l2, a2, b2, lightness, chroma)
+}
@@ -0,0 +1,48 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeltaE2000Sharma(t *testing.T) {
+	assert := assert.New(t)
+	tests := [][7]float64{
+		{50, 2.6772, -79.7751, 50, 0, -82.7485, 2.0425},
+		{50, 0, 0, 50, -1, 2, 2.3669},
+		{50, 2.5, 0, 73, 25, -18, 27.1492},
+		{60.2574, -34.0099, 36.2677, 60.4626, -34.1751, 39.4387, 1.2644},
+	}
+	for _, v := range tests {
+		assert.InDelta(v[6], deltaE2000(v[0], v[1], v[2], v[3], v[4], v[5]), 1e-4)
+		assert.InDelta(v[6], deltaE2000(v[3], v[4], v[5], v[0], v[1], v[2]), 1e-4)
+	}
+}
+
+func TestDeltaE76(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0.0, NewHTML("Red").DeltaE76(NewHTML("Red")))
+	assert.InDelta(100, NewHTML("Black").DeltaE76(NewHTML("White")), 1e-9)
+	assert.InDelta(3.30, NewRGB(219, 112, 147).DeltaE76(NewRGB(219, 112, 153)), 0.01)
+}
+
+func TestDeltaE94(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0.0, NewHTML("Red").DeltaE94(NewHTML("Red")))
+	assert.InDelta(100, NewHTML("Black").DeltaE94(NewHTML("White")), 1e-9)
+	assert.InDelta(1.92, NewRGB(219, 112, 147).DeltaE94(NewRGB(219, 112, 153)), 0.01)
+}
+
+func TestDeltaE2000(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0.0, NewHTML("Red").DeltaE2000(NewHTML("Red")))
+	assert.InDelta(100, NewHTML("Black").DeltaE2000(NewHTML("White")), 1e-9)
+	assert.InDelta(1.68, NewRGB(219, 112, 147).DeltaE2000(NewRGB(219, 112, 153)), 0.01)
+}
+
+func TestDeltaECMC(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0.0, NewHTML("Red").DeltaECMC(NewHTML("Red"), 2, 1))
+	assert.InDelta(1.92, NewRGB(219, 112, 147).DeltaECMC(NewRGB(219, 112, 153), 2, 1), 0.01)
+}
@@ -0,0 +1,191 @@
+package noire
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Encoding is the canonical form of a serialized color.
+type Encoding int
+
+const (
+	// EncodingHex serializes the color as a `#` prefixed Hex string, the alpha channel is appended (`#RRGGBBAA`) only if the color is not opaque.
+	EncodingHex Encoding = iota
+	// EncodingHexAlpha serializes the color as a `#` prefixed Hex string with the alpha channel (`#RRGGBBAA`) even if the color is opaque.
+	EncodingHexAlpha
+	// EncodingObject serializes the color as a JSON object, like: `{"r":219,"g":112,"b":147,"a":1}`.
+	// The text forms (`MarshalText` and `Value`) are not JSON, so they use `EncodingHexAlpha` instead.
+	EncodingObject
+)
+
+// colorObject is the JSON object form of a color.
+type colorObject struct {
+	R float64  `json:"r"`
+	G float64  `json:"g"`
+	B float64  `json:"b"`
+	A *float64 `json:"a,omitempty"`
+}
+
+// encodeText serializes the color as a string with the encoding, `EncodingObject` is serialized as `EncodingHexAlpha`.
+func (c Color) encodeText(e Encoding) ([]byte, error) {
+	switch e {
+	case EncodingHex, EncodingHexAlpha, EncodingObject:
+		s := "#" + c.Hex()
+		if e != EncodingHex || c.Alpha != 1 {
+			s += fmt.Sprintf("%02X", uint8(math.Round(c.Alpha*255)))
+		}
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("noire: unknown encoding %d", e)
+}
+
+// encodeJSON serializes the color as a JSON string or a JSON object with the encoding.
+func (c Color) encodeJSON(e Encoding) ([]byte, error) {
+	if e == EncodingObject {
+		a := c.Alpha
+		return json.Marshal(colorObject{R: c.Red, G: c.Green, B: c.Blue, A: &a})
+	}
+	b, err := c.encodeText(e)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+// decode deserializes the color from a JSON object or any string that `Parse` accepts.
+func (c *Color) decode(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		var v colorObject
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		a := 1.0
+		if v.A != nil {
+			a = *v.A
+		}
+		*c = newColor(v.R, v.G, v.B, a)
+		return nil
+	}
+	v, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// decodeJSON deserializes the color from a JSON string or a JSON object, the `null` is ignored.
+func (c *Color) decodeJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return c.decode([]byte(s))
+	}
+	return c.decode(data)
+}
+
+// decodeSQL deserializes the color from a database value, the `NULL` is ignored.
+func (c *Color) decodeSQL(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return c.decode([]byte(v))
+	case []byte:
+		return c.decode(v)
+	}
+	return fmt.Errorf("noire: cannot scan %T into a color", src)
+}
+
+// MarshalText implements the `encoding.TextMarshaler` interface with the `EncodingHex`, use `Color.As` for the other encodings.
+func (c Color) MarshalText() ([]byte, error) {
+	return c.encodeText(EncodingHex)
+}
+
+// UnmarshalText implements the `encoding.TextUnmarshaler` interface, it accepts all the encodings and any string that `Parse` accepts.
+func (c *Color) UnmarshalText(data []byte) error {
+	return c.decode(data)
+}
+
+// MarshalJSON implements the `json.Marshaler` interface with the `EncodingHex`, use `MarshalJSONAs` or `Color.As` for the other encodings.
+func (c Color) MarshalJSON() ([]byte, error) {
+	return c.encodeJSON(EncodingHex)
+}
+
+// MarshalJSONAs returns the JSON of the color with the encoding.
+func (c Color) MarshalJSONAs(e Encoding) ([]byte, error) {
+	return c.encodeJSON(e)
+}
+
+// UnmarshalJSON implements the `json.Unmarshaler` interface, it accepts all the encodings and the `null` is ignored.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	return c.decodeJSON(data)
+}
+
+// Value implements the `driver.Valuer` interface, the color is stored as a string with the `EncodingHex`.
+func (c Color) Value() (driver.Value, error) {
+	b, err := c.encodeText(EncodingHex)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the `sql.Scanner` interface, the `NULL` is ignored.
+func (c *Color) Scan(src interface{}) error {
+	return c.decodeSQL(src)
+}
+
+// EncodedColor is a color serialized with a specified encoding, it can be used as a struct field instead of `Color`
+// to choose the encoding per field without a global setting, like: `Primary noire.EncodedColor` with `c.As(noire.EncodingObject)`.
+// The unmarshalers accept all the encodings and keep the `Encoding` as it is.
+type EncodedColor struct {
+	Color
+	Encoding Encoding
+}
+
+// As returns the current color with the encoding for the marshalers.
+func (c Color) As(e Encoding) EncodedColor {
+	return EncodedColor{Color: c, Encoding: e}
+}
+
+// MarshalText implements the `encoding.TextMarshaler` interface, `EncodingObject` is serialized as `EncodingHexAlpha` since the text is not JSON.
+func (c EncodedColor) MarshalText() ([]byte, error) {
+	return c.Color.encodeText(c.Encoding)
+}
+
+// UnmarshalText implements the `encoding.TextUnmarshaler` interface.
+func (c *EncodedColor) UnmarshalText(data []byte) error {
+	return c.Color.decode(data)
+}
+
+// MarshalJSON implements the `json.Marshaler` interface with the encoding.
+func (c EncodedColor) MarshalJSON() ([]byte, error) {
+	return c.Color.encodeJSON(c.Encoding)
+}
+
+// UnmarshalJSON implements the `json.Unmarshaler` interface, the `null` is ignored.
+func (c *EncodedColor) UnmarshalJSON(data []byte) error {
+	return c.Color.decodeJSON(data)
+}
+
+// Value implements the `driver.Valuer` interface, the color is stored as a string with the encoding.
+func (c EncodedColor) Value() (driver.Value, error) {
+	b, err := c.Color.encodeText(c.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the `sql.Scanner` interface, the `NULL` is ignored.
+func (c *EncodedColor) Scan(src interface{}) error {
+	return c.Color.decodeSQL(src)
+}
@@ -0,0 +1,132 @@
+package noire
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalText(t *testing.T) {
+	assert := assert.New(t)
+	b, err := NewRGB(219, 112, 147).MarshalText()
+	assert.NoError(err)
+	assert.Equal("#DB7093", string(b))
+	b, err = NewRGBA(219, 112, 147, 0.5).MarshalText()
+	assert.NoError(err)
+	assert.Equal("#DB709380", string(b))
+}
+
+func TestUnmarshalText(t *testing.T) {
+	assert := assert.New(t)
+	var c Color
+	assert.NoError(c.UnmarshalText([]byte("#DB709380")))
+	assert.Equal("DB7093", c.Hex())
+	assert.InDelta(0.5, c.Alpha, 0.01)
+	assert.NoError(c.UnmarshalText([]byte("rgb(255 0 0)")))
+	assert.Equal("FF0000", c.Hex())
+	assert.NoError(c.UnmarshalText([]byte(`{"r":0,"g":0,"b":255}`)))
+	assert.Equal("0000FF", c.Hex())
+	assert.Equal(1.0, c.Alpha)
+	assert.True(errors.Is(c.UnmarshalText([]byte("NinjaTurtle")), ErrUnknownName))
+}
+
+func TestMarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+	v := struct {
+		Primary Color  `json:"primary"`
+		Overlay *Color `json:"overlay"`
+	}{NewRGB(219, 112, 147), &Color{Alpha: 0.5}}
+	b, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"primary":"#DB7093","overlay":"#00000080"}`, string(b))
+}
+
+func TestMarshalJSONAs(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	b, err := c.MarshalJSONAs(EncodingHex)
+	assert.NoError(err)
+	assert.Equal(`"#DB7093"`, string(b))
+	b, err = c.MarshalJSONAs(EncodingHexAlpha)
+	assert.NoError(err)
+	assert.Equal(`"#DB7093FF"`, string(b))
+	b, err = c.MarshalJSONAs(EncodingObject)
+	assert.NoError(err)
+	assert.Equal(`{"r":219,"g":112,"b":147,"a":1}`, string(b))
+	_, err = c.MarshalJSONAs(Encoding(-1))
+	assert.Error(err)
+}
+
+func TestEncodedColor(t *testing.T) {
+	assert := assert.New(t)
+	v := struct {
+		Primary EncodedColor  `json:"primary"`
+		Overlay *EncodedColor `json:"overlay"`
+		Border  EncodedColor  `json:"border"`
+	}{NewRGB(219, 112, 147).As(EncodingHexAlpha), &EncodedColor{Color{Alpha: 0.5}, EncodingObject}, NewHTML("Red").As(EncodingHex)}
+	b, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"primary":"#DB7093FF","overlay":{"r":0,"g":0,"b":0,"a":0.5},"border":"#FF0000"}`, string(b))
+
+	assert.NoError(json.Unmarshal([]byte(`{"primary":"PaleVioletRed","overlay":"#00000080","border":null}`), &v))
+	assert.Equal("PaleVioletRed", v.Primary.HTML())
+	assert.Equal(EncodingHexAlpha, v.Primary.Encoding)
+	assert.InDelta(0.5, v.Overlay.Alpha, 0.01)
+	assert.Equal(EncodingObject, v.Overlay.Encoding)
+	assert.Equal("Red", v.Border.HTML())
+
+	// The text forms are never JSON objects.
+	b, err = v.Overlay.MarshalText()
+	assert.NoError(err)
+	assert.Equal("#00000080", string(b))
+	d, err := NewRGB(219, 112, 147).As(EncodingObject).Value()
+	assert.NoError(err)
+	assert.Equal("#DB7093FF", d)
+	_, err = NewRGB(219, 112, 147).As(Encoding(-1)).MarshalText()
+	assert.Error(err)
+
+	var c EncodedColor
+	assert.NoError(c.UnmarshalText([]byte("rgb(255 0 0)")))
+	assert.Equal("FF0000", c.Hex())
+	assert.NoError(c.Scan([]byte("#00F")))
+	assert.Equal("0000FF", c.Hex())
+	assert.Error(c.Scan(12))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+	var v struct {
+		Primary Color `json:"primary"`
+		Overlay Color `json:"overlay"`
+		Border  Color `json:"border"`
+	}
+	v.Border = NewHTML("Red")
+	err := json.Unmarshal([]byte(`{"primary":"PaleVioletRed","overlay":{"r":0,"g":0,"b":0,"a":0.5},"border":null}`), &v)
+	assert.NoError(err)
+	assert.Equal("PaleVioletRed", v.Primary.HTML())
+	assert.Equal(0.5, v.Overlay.Alpha)
+	assert.Equal("Red", v.Border.HTML())
+	assert.Error(json.Unmarshal([]byte(`{"primary":"#GG0000"}`), &v))
+	assert.Error(json.Unmarshal([]byte(`{"primary":12}`), &v))
+}
+
+func TestValue(t *testing.T) {
+	assert := assert.New(t)
+	v, err := NewRGBA(219, 112, 147, 0.5).Value()
+	assert.NoError(err)
+	assert.Equal("#DB709380", v)
+}
+
+func TestScan(t *testing.T) {
+	assert := assert.New(t)
+	var c Color
+	assert.NoError(c.Scan("#DB7093"))
+	assert.Equal("PaleVioletRed", c.HTML())
+	assert.NoError(c.Scan([]byte("#F00")))
+	assert.Equal("Red", c.HTML())
+	assert.NoError(c.Scan(nil))
+	assert.Equal("Red", c.HTML())
+	assert.Error(c.Scan(12))
+}
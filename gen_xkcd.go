@@ -0,0 +1,86 @@
+//go:build ignore
+// +build ignore
+
+// gen_xkcd generates `colors_xkcd.go` from the `rgb.txt` file of the xkcd color survey (the 949 most common names),
+// run it with `go generate` or `go run gen_xkcd.go [file or URL]`.
+//
+// reference: https://xkcd.com/color/rgb/
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const source = "https://xkcd.com/color/rgb.txt"
+
+func main() {
+	src := source
+	if len(os.Args) > 1 {
+		src = os.Args[1]
+	}
+	r, err := open(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_xkcd.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package noire\n\n")
+	buf.WriteString("// xkcdColors are the colors of the xkcd color survey, the names are the same as the survey results.\n")
+	buf.WriteString("//\n// reference: https://xkcd.com/color/rgb/\n")
+	buf.WriteString("var xkcdColors = []namedColor{\n")
+	n := 0
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "License:") {
+			continue
+		}
+		// The lines are like: `cloudy blue	#acc2d9`.
+		i := strings.LastIndexByte(line, '#')
+		if i == -1 || len(line)-i != 7 {
+			log.Fatalf("invalid line: %q", line)
+		}
+		fmt.Fprintf(&buf, "\t{%q, %q},\n", strings.TrimSpace(line[:i]), strings.ToUpper(line[i+1:]))
+		n++
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	buf.WriteString("}\n")
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("colors_xkcd.go", b, 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("generated %d colors", n)
+}
+
+// open opens the local file or downloads the URL.
+func open(src string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.Open(src)
+	}
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", src, resp.Status)
+	}
+	return resp.Body, nil
+}
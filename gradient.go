@@ -0,0 +1,189 @@
+package noire
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Stop is a color of the gradient at the position (from `0` to `1`).
+type Stop struct {
+	Color    Color
+	Position float64
+}
+
+// Easing remaps the position (from `0` to `1`) of the gradient before the color is sampled.
+type Easing func(t float64) float64
+
+// EaseLinear keeps the position as it is.
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseIn starts slowly and speeds up toward the end (quadratic).
+func EaseIn(t float64) float64 {
+	return t * t
+}
+
+// EaseOut starts quickly and slows down toward the end (quadratic).
+func EaseOut(t float64) float64 {
+	return t * (2 - t)
+}
+
+// EaseInOut starts and ends slowly (smoothstep).
+func EaseInOut(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// cssSamples is the count of the stops used to express a gradient that CSS can't interpolate natively.
+const cssSamples = 16
+
+// Gradient is a color gradient with multiple stops, the stops must be sorted by the position.
+// The colors between the stops are interpolated in `Space` with the `Hue` interpolation method, and `Easing` (can be `nil`) remaps the position before the sampling.
+type Gradient struct {
+	Stops  []Stop
+	Space  Space
+	Hue    HueInterpolation
+	Easing Easing
+}
+
+// NewGradient creates a gradient interpolated in OKLab (the default of CSS) with the stops, the stops are sorted by the position.
+func NewGradient(stops ...Stop) Gradient {
+	s := append([]Stop(nil), stops...)
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].Position < s[j].Position
+	})
+	return Gradient{Stops: s, Space: SpaceOKLab}
+}
+
+// NewGradientColors creates a gradient interpolated in OKLab with the colors evenly spaced from `0` to `1`.
+func NewGradientColors(colors ...Color) Gradient {
+	s := make([]Stop, len(colors))
+	for i, v := range colors {
+		s[i] = Stop{Color: v}
+		if len(colors) > 1 {
+			s[i].Position = float64(i) / float64(len(colors)-1)
+		}
+	}
+	return Gradient{Stops: s, Space: SpaceOKLab}
+}
+
+// At returns the color at the position `t` (from `0` to `1`), the first or the last color is returned if the position is outside of the stops.
+// Two stops at the same position make a hard transition. It returns a transparent black color if the gradient doesn't have any stop.
+func (g Gradient) At(t float64) Color {
+	if len(g.Stops) == 0 {
+		return Color{}
+	}
+	t = math.Max(0, math.Min(1, t))
+	if g.Easing != nil {
+		t = g.Easing(t)
+	}
+	if t < g.Stops[0].Position {
+		return g.Stops[0].Color
+	}
+	for i := 1; i < len(g.Stops); i++ {
+		prev, next := g.Stops[i-1], g.Stops[i]
+		if t < next.Position {
+			return Interpolate(prev.Color, next.Color, (t-prev.Position)/(next.Position-prev.Position), g.Space, g.Hue)
+		}
+	}
+	return g.Stops[len(g.Stops)-1].Color
+}
+
+// Colors returns `n` colors evenly sampled from the start to the end of the gradient, like a scale for the heatmaps.
+func (g Gradient) Colors(n int) []Color {
+	if n <= 0 {
+		return nil
+	}
+	colors := make([]Color, n)
+	for i := range colors {
+		var t float64
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		colors[i] = g.At(t)
+	}
+	return colors
+}
+
+// cssName returns the CSS name of the color space, it's empty if CSS doesn't have the same color space (CSS uses CIELAB with D50 instead of D65).
+func (s Space) cssName() string {
+	switch s {
+	case SpaceSRGB:
+		return "srgb"
+	case SpaceLinearSRGB:
+		return "srgb-linear"
+	case SpaceHSL:
+		return "hsl"
+	case SpaceHWB:
+		return "hwb"
+	case SpaceOKLab:
+		return "oklab"
+	case SpaceOKLCH:
+		return "oklch"
+	}
+	return ""
+}
+
+// cssName returns the CSS keyword of the hue interpolation method, it's empty for `HueShorter` (the default of CSS) and the unknown methods.
+func (h HueInterpolation) cssName() string {
+	switch h {
+	case HueLonger:
+		return "longer"
+	case HueIncreasing:
+		return "increasing"
+	case HueDecreasing:
+		return "decreasing"
+	}
+	return ""
+}
+
+// css returns the color interpolation method (empty for sRGB, the default of the legacy colors) and the color stops of the CSS gradient functions.
+// The gradient is sampled to multiple stops in sRGB if CSS can't express the color space or the easing.
+// CSS needs at least 2 stops, so a gradient without any stop is a transparent fill and a single stop is a solid fill.
+func (g Gradient) css() (method string, stops string) {
+	switch len(g.Stops) {
+	case 0:
+		return "", "transparent 0%, transparent 100%"
+	case 1:
+		c := g.Stops[0].Color.Format(StyleHex)
+		return "", c + " 0%, " + c + " 100%"
+	}
+	var args []string
+	name := g.Space.cssName()
+	if name == "" || g.Easing != nil {
+		for i, v := range g.Colors(cssSamples) {
+			args = append(args, v.Format(StyleHex)+" "+formatNumber(float64(i)/(cssSamples-1)*100, 2)+"%")
+		}
+		return "", strings.Join(args, ", ")
+	}
+	if name != "srgb" {
+		method = "in " + name
+		if hue := g.Hue.cssName(); g.Space.hueIndex() != -1 && hue != "" {
+			method += " " + hue + " hue"
+		}
+	}
+	for _, v := range g.Stops {
+		args = append(args, v.Color.Format(StyleHex)+" "+formatNumber(v.Position*100, 2)+"%")
+	}
+	return method, strings.Join(args, ", ")
+}
+
+// LinearCSS returns the CSS `linear-gradient()` string of the gradient with the angle in degrees (`180` is from top to bottom),
+// like: `linear-gradient(90deg in oklab, #F00 0%, #00F 100%)`.
+func (g Gradient) LinearCSS(angle float64) string {
+	method, stops := g.css()
+	if method != "" {
+		method = " " + method
+	}
+	return "linear-gradient(" + formatNumber(angle, 2) + "deg" + method + ", " + stops + ")"
+}
+
+// RadialCSS returns the CSS `radial-gradient()` string of the gradient from the center, like: `radial-gradient(in oklab, #F00 0%, #00F 100%)`.
+func (g Gradient) RadialCSS() string {
+	method, stops := g.css()
+	if method != "" {
+		method += ", "
+	}
+	return "radial-gradient(" + method + stops + ")"
+}
@@ -0,0 +1,104 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGradient(t *testing.T) {
+	assert := assert.New(t)
+	g := NewGradient(Stop{NewHTML("Blue"), 1}, Stop{NewHTML("Red"), 0}, Stop{NewHTML("Yellow"), 0.25})
+	assert.Equal(SpaceOKLab, g.Space)
+	assert.Equal([]float64{0, 0.25, 1}, []float64{g.Stops[0].Position, g.Stops[1].Position, g.Stops[2].Position})
+	assert.Equal("FF0000", g.Stops[0].Color.Hex())
+
+	g = NewGradientColors(NewHTML("Red"), NewHTML("Yellow"), NewHTML("Blue"))
+	assert.Equal([]float64{0, 0.5, 1}, []float64{g.Stops[0].Position, g.Stops[1].Position, g.Stops[2].Position})
+	g = NewGradientColors(NewHTML("Red"))
+	assert.Equal(0.0, g.Stops[0].Position)
+}
+
+func TestGradientAt(t *testing.T) {
+	assert := assert.New(t)
+	g := NewGradient(Stop{NewHTML("Red"), 0.2}, Stop{NewHTML("Blue"), 0.8})
+	assert.Equal("FF0000", g.At(0).Hex())
+	assert.Equal("FF0000", g.At(-1).Hex())
+	assert.Equal("8C53A2", g.At(0.5).Hex())
+	assert.Equal("0000FF", g.At(0.9).Hex())
+	assert.Equal("0000FF", g.At(2).Hex())
+
+	g.Space = SpaceHSL
+	assert.Equal("FF00FF", g.At(0.5).Hex())
+	g.Hue = HueLonger
+	assert.Equal("00FF00", g.At(0.5).Hex())
+
+	g = NewGradient(Stop{NewHTML("Red"), 0.5}, Stop{NewHTML("Blue"), 0.5})
+	assert.Equal("FF0000", g.At(0.49).Hex())
+	assert.Equal("0000FF", g.At(0.5).Hex())
+
+	g = NewGradientColors(NewHTML("Red"), NewHTML("Yellow"), NewHTML("Blue"))
+	want := g.At(0.25)
+	g.Easing = EaseIn
+	assert.Equal(want, g.At(0.5))
+	assert.Equal(Color{}, Gradient{}.At(0.5))
+}
+
+func TestGradientColors(t *testing.T) {
+	assert := assert.New(t)
+	g := NewGradient(Stop{NewHTML("Red"), 0}, Stop{NewHTML("Yellow"), 0.25}, Stop{NewHTML("Blue"), 1})
+	g.Space = SpaceSRGB
+	var hexes []string
+	for _, v := range g.Colors(5) {
+		hexes = append(hexes, v.Hex())
+	}
+	assert.Equal([]string{"FF0000", "FFFF00", "AAAA55", "5555AA", "0000FF"}, hexes)
+	assert.Equal("FF0000", g.Colors(1)[0].Hex())
+	assert.Nil(g.Colors(0))
+}
+
+func TestEasing(t *testing.T) {
+	assert := assert.New(t)
+	for _, f := range []Easing{EaseLinear, EaseIn, EaseOut, EaseInOut} {
+		assert.Equal(0.0, f(0))
+		assert.Equal(1.0, f(1))
+	}
+	assert.Equal(0.25, EaseIn(0.5))
+	assert.Equal(0.75, EaseOut(0.5))
+	assert.Equal(0.5, EaseInOut(0.5))
+}
+
+func TestGradientCSS(t *testing.T) {
+	assert := assert.New(t)
+	g := NewGradient(Stop{NewHTML("Red"), 0}, Stop{NewHTML("Yellow"), 0.25}, Stop{NewHTML("Blue"), 1})
+	assert.Equal("linear-gradient(90deg in oklab, #F00 0%, #FF0 25%, #00F 100%)", g.LinearCSS(90))
+	assert.Equal("radial-gradient(in oklab, #F00 0%, #FF0 25%, #00F 100%)", g.RadialCSS())
+
+	g.Space, g.Hue = SpaceOKLCH, HueLonger
+	assert.Equal("linear-gradient(90deg in oklch longer hue, #F00 0%, #FF0 25%, #00F 100%)", g.LinearCSS(90))
+	g.Hue = HueDecreasing
+	assert.Equal("linear-gradient(90deg in oklch decreasing hue, #F00 0%, #FF0 25%, #00F 100%)", g.LinearCSS(90))
+	// An unknown method is the same as `HueShorter`.
+	g.Hue = HueInterpolation(42)
+	assert.Equal("linear-gradient(90deg in oklch, #F00 0%, #FF0 25%, #00F 100%)", g.LinearCSS(90))
+	g.Hue = HueInterpolation(-1)
+	assert.Equal("radial-gradient(in oklch, #F00 0%, #FF0 25%, #00F 100%)", g.RadialCSS())
+	g.Space, g.Hue = SpaceSRGB, HueShorter
+	assert.Equal("linear-gradient(180deg, #F00 0%, #FF0 25%, #00F 100%)", g.LinearCSS(180))
+	assert.Equal("radial-gradient(#F00 0%, #FF0 25%, #00F 100%)", g.RadialCSS())
+
+	// CSS can't express the easing so the gradient is sampled.
+	g.Easing = EaseIn
+	css := g.RadialCSS()
+	assert.Contains(css, "radial-gradient(#F00 0%, #FF0500 6.67%, ")
+	assert.Contains(css, ", #00F 100%)")
+
+	// CSS needs at least 2 stops.
+	g = NewGradient()
+	assert.Equal("linear-gradient(90deg, transparent 0%, transparent 100%)", g.LinearCSS(90))
+	assert.Equal("radial-gradient(transparent 0%, transparent 100%)", g.RadialCSS())
+	g = NewGradient(Stop{NewHTML("Red"), 0.5})
+	assert.Equal("linear-gradient(90deg, #F00 0%, #F00 100%)", g.LinearCSS(90))
+	g.Easing = EaseIn
+	assert.Equal("radial-gradient(#F00 0%, #F00 100%)", g.RadialCSS())
+}
@@ -0,0 +1,137 @@
+package noire
+
+import "math"
+
+// Harmony generates the color harmonies of a color by rotating the hue in a color space,
+// the hue of CIELCh or OKLCH is perceptually more uniform than HSL, and the colors outside of the sRGB gamut are clipped.
+type Harmony struct {
+	Color Color
+	// Space is the color space to rotate the hue, `SpaceLab` and `SpaceLCh` use CIELCh, `SpaceOKLab` and `SpaceOKLCH` use OKLCH, the others use HSL.
+	Space Space
+}
+
+// HarmonyIn returns the color harmony generator of the current color in the specified color space.
+func (c Color) HarmonyIn(space Space) Harmony {
+	return Harmony{Color: c, Space: space}
+}
+
+// rotate rotates the hue of the color by the degrees.
+func (h Harmony) rotate(degrees float64) Color {
+	c := h.Color
+	switch h.Space {
+	case SpaceLab, SpaceLCh:
+		l, ch, hue := c.LCh()
+		l, a, b := LChToLab(l, ch, hue+degrees)
+		r, g, bl := LabToRGB(l, a, b, D65)
+		return newColor(r, g, bl, c.Alpha)
+	case SpaceOKLab, SpaceOKLCH:
+		l, ch, hue := c.OKLCH()
+		r, g, b := OKLabToRGB(OKLCHToOKLab(l, ch, hue+degrees))
+		return newColor(r, g, b, c.Alpha)
+	}
+	return c.AdjustHue(math.Mod(degrees, 360))
+}
+
+// rotations returns the color itself and the colors rotated by the degrees.
+func (h Harmony) rotations(degrees ...float64) []Color {
+	colors := []Color{h.Color}
+	for _, v := range degrees {
+		colors = append(colors, h.rotate(v))
+	}
+	return colors
+}
+
+// Complement returns the color and its complementary color.
+func (h Harmony) Complement() []Color {
+	return h.rotations(180)
+}
+
+// Triadic returns the color and 2 colors evenly spaced around the color wheel (`120` degrees apart).
+func (h Harmony) Triadic() []Color {
+	return h.rotations(120, 240)
+}
+
+// Tetradic returns the color and 3 colors forming a rectangle on the color wheel (two complementary pairs `60` degrees apart).
+func (h Harmony) Tetradic() []Color {
+	return h.rotations(60, 180, 240)
+}
+
+// Square returns the color and 3 colors evenly spaced around the color wheel (`90` degrees apart).
+func (h Harmony) Square() []Color {
+	return h.rotations(90, 180, 270)
+}
+
+// SplitComplementary returns the color and 2 colors adjacent to its complementary color, `angle` is the degrees away from the complement (commonly `30`).
+func (h Harmony) SplitComplementary(angle float64) []Color {
+	return h.rotations(180-angle, 180+angle)
+}
+
+// Analogous returns `n` colors next to each other on the color wheel with `angle` degrees apart (commonly `30`),
+// the colors are centered on the color, so the color itself is in the middle if `n` is odd.
+func (h Harmony) Analogous(n int, angle float64) []Color {
+	if n <= 0 {
+		return nil
+	}
+	colors := make([]Color, n)
+	for i := range colors {
+		colors[i] = h.rotate((float64(i) - float64(n-1)/2) * angle)
+	}
+	return colors
+}
+
+// Monochromatic returns `n` colors with the same hue and saturation (or chroma) of the color, the lightness is evenly spaced from dark to light without black and white.
+func (h Harmony) Monochromatic(n int) []Color {
+	if n <= 0 {
+		return nil
+	}
+	c := h.Color
+	colors := make([]Color, n)
+	for i := range colors {
+		t := float64(i+1) / float64(n+1)
+		var r, g, b float64
+		switch h.Space {
+		case SpaceLab, SpaceLCh:
+			_, ch, hue := c.LCh()
+			l, x, y := LChToLab(t*100, ch, hue)
+			r, g, b = LabToRGB(l, x, y, D65)
+		case SpaceOKLab, SpaceOKLCH:
+			_, ch, hue := c.OKLCH()
+			r, g, b = OKLabToRGB(OKLCHToOKLab(t, ch, hue))
+		default:
+			hue, s, _ := c.HSLExact()
+			r, g, b = HSLToRGBExact(hue, s, t*100)
+		}
+		colors[i] = newColor(r, g, b, c.Alpha)
+	}
+	return colors
+}
+
+// Triadic returns the color and 2 colors evenly spaced around the HSL color wheel, see `HarmonyIn` for the other color spaces.
+func (c Color) Triadic() []Color {
+	return c.HarmonyIn(SpaceHSL).Triadic()
+}
+
+// Tetradic returns the color and 3 colors forming a rectangle on the HSL color wheel.
+func (c Color) Tetradic() []Color {
+	return c.HarmonyIn(SpaceHSL).Tetradic()
+}
+
+// Square returns the color and 3 colors evenly spaced around the HSL color wheel.
+func (c Color) Square() []Color {
+	return c.HarmonyIn(SpaceHSL).Square()
+}
+
+// SplitComplementary returns the color and 2 colors adjacent to its complementary color with `angle` degrees away from the complement.
+func (c Color) SplitComplementary(angle float64) []Color {
+	return c.HarmonyIn(SpaceHSL).SplitComplementary(angle)
+}
+
+// Analogous returns `n` colors next to each other on the HSL color wheel with `angle` degrees apart, centered on the color.
+func (c Color) Analogous(n int, angle float64) []Color {
+	return c.HarmonyIn(SpaceHSL).Analogous(n, angle)
+}
+
+// Monochromatic returns `n` colors with the same HSL hue and saturation of the color, the lightness is evenly spaced from dark to light.
+func (c Color) Monochromatic(n int) []Color {
+	return c.HarmonyIn(SpaceHSL).Monochromatic(n)
+}
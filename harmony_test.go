@@ -0,0 +1,71 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func hexes(colors []Color) []string {
+	var s []string
+	for _, v := range colors {
+		s = append(s, v.Hex())
+	}
+	return s
+}
+
+func TestTriadic(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"FF0000", "00FF00", "0000FF"}, hexes(NewHTML("Red").Triadic()))
+	assert.Equal([]string{"DB7093", "93DB70", "7093DB"}, hexes(NewRGB(219, 112, 147).Triadic()))
+}
+
+func TestTetradic(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"FF0000", "FFFF00", "00FFFF", "0000FF"}, hexes(NewHTML("Red").Tetradic()))
+}
+
+func TestSquare(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"FF0000", "80FF00", "00FFFF", "7F00FF"}, hexes(NewHTML("Red").Square()))
+}
+
+func TestSplitComplementary(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"FF0000", "00FF80", "007FFF"}, hexes(NewHTML("Red").SplitComplementary(30)))
+}
+
+func TestAnalogous(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"FF0080", "FF0000", "FF8000"}, hexes(NewHTML("Red").Analogous(3, 30)))
+	assert.Equal([]string{"FF00BF", "FF0040", "FF4000", "FFBF00"}, hexes(NewHTML("Red").Analogous(4, 30)))
+	assert.Nil(NewHTML("Red").Analogous(0, 30))
+}
+
+func TestMonochromatic(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"800000", "FF0000", "FF8080"}, hexes(NewHTML("Red").Monochromatic(3)))
+	assert.Nil(NewHTML("Red").Monochromatic(0))
+}
+
+func TestHarmonyIn(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGBA(219, 112, 147, 0.5)
+	h := c.HarmonyIn(SpaceLCh)
+	assert.Equal([]string{"DB7093", "809C4A", "00A0D7"}, hexes(h.Triadic()))
+	assert.Equal([]string{"DB7093", "00A691"}, hexes(h.Complement()))
+	assert.Equal([]string{"750D3C", "BC5478", "FF97BA"}, hexes(h.Monochromatic(3)))
+	assert.Equal(0.5, h.Triadic()[1].Alpha)
+
+	h = c.HarmonyIn(SpaceOKLCH)
+	assert.Equal([]string{"DB7093", "8CA434", "33A1E4"}, hexes(h.Triadic()))
+	assert.Equal([]string{"4E001E", "9F3A5F", "F486A9"}, hexes(h.Monochromatic(3)))
+
+	// The lightness and chroma are kept while rotating in LCh.
+	l1, c1, _ := c.LCh()
+	l2, c2, _ := NewHTML("PaleVioletRed").HarmonyIn(SpaceLCh).Analogous(3, 10)[0].LCh()
+	assert.InDelta(l1, l2, 0.5)
+	assert.InDelta(c1, c2, 0.5)
+
+	assert.Equal(hexes(c.Triadic()), hexes(c.HarmonyIn(SpaceSRGB).Triadic()))
+}
@@ -0,0 +1,195 @@
+package noire
+
+// WhitePoint represents the reference white of an illuminant in XYZ (with `Y` normalized to `100`).
+type WhitePoint struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+var (
+	// D50 is the reference white of the CIE standard illuminant D50 (horizon light), used by the CSS `lab()` and `lch()` functions.
+	D50 = WhitePoint{whiteD50[0] * 100, whiteD50[1] * 100, whiteD50[2] * 100}
+	// D65 is the reference white of the CIE standard illuminant D65 (noon daylight), used by the sRGB color space.
+	D65 = WhitePoint{whiteD65[0] * 100, whiteD65[1] * 100, whiteD65[2] * 100}
+)
+
+// normalized returns the white point with `Y` normalized to `1`.
+func (w WhitePoint) normalized() [3]float64 {
+	return [3]float64{w.X / 100, w.Y / 100, w.Z / 100}
+}
+
+// reference: http://www.brucelindbloom.com/index.html?Eqn_ChromAdapt.html
+var (
+	bradfordMatrix = matrix3{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+	bradfordInverseMatrix = matrix3{
+		{0.9869929, -0.1470543, 0.1599627},
+		{0.4323053, 0.5183603, 0.0492912},
+		{-0.0085287, 0.0400428, 0.9684867},
+	}
+)
+
+// RGBToXYZ converts the color from RGB to CIE XYZ (D65, `Y` ranges from `0` to `100`) with the sRGB linearization.
+//
+// reference: https://www.w3.org/TR/css-color-4/#color-conversion-code
+func RGBToXYZ(r float64, g float64, b float64) (x float64, y float64, z float64) {
+	x, y, z = linearRGBToXYZMatrix.mul(rgbToLinear(r, g, b))
+	return x * 100, y * 100, z * 100
+}
+
+// XYZToRGB converts the color from CIE XYZ (D65, `Y` ranges from `0` to `100`) to RGB, the result can be out of the `0` - `255` range if the color is outside of the sRGB gamut.
+//
+// reference: https://www.w3.org/TR/css-color-4/#color-conversion-code
+func XYZToRGB(x float64, y float64, z float64) (r float64, g float64, b float64) {
+	return linearToRGB(xyzToLinearRGBMatrix.mul(x/100, y/100, z/100))
+}
+
+// AdaptXYZ converts the CIE XYZ color from a reference white to another with the Bradford chromatic adaptation,
+// the adaptation between `D50` and `D65` uses the same matrices as CSS so the results match the CSS `lab()` and `lch()` functions.
+//
+// reference: http://www.brucelindbloom.com/index.html?Eqn_ChromAdapt.html
+func AdaptXYZ(x float64, y float64, z float64, from WhitePoint, to WhitePoint) (float64, float64, float64) {
+	switch {
+	case from == to:
+		return x, y, z
+	case from == D50 && to == D65:
+		return d50ToD65Matrix.mul(x, y, z)
+	case from == D65 && to == D50:
+		return d65ToD50Matrix.mul(x, y, z)
+	}
+	sr, sg, sb := bradfordMatrix.mul(from.X, from.Y, from.Z)
+	dr, dg, db := bradfordMatrix.mul(to.X, to.Y, to.Z)
+	r, g, b := bradfordMatrix.mul(x, y, z)
+	return bradfordInverseMatrix.mul(r*dr/sr, g*dg/sg, b*db/sb)
+}
+
+// XYZToLab converts the color from CIE XYZ (`Y` ranges from `0` to `100`) to CIELAB relative to the reference white.
+//
+// reference: http://www.brucelindbloom.com/index.html?Eqn_XYZ_to_Lab.html
+func XYZToLab(x float64, y float64, z float64, white WhitePoint) (l float64, a float64, b float64) {
+	return xyzToLab(x/100, y/100, z/100, white.normalized())
+}
+
+// LabToXYZ converts the color from CIELAB to CIE XYZ (`Y` ranges from `0` to `100`) relative to the reference white.
+//
+// reference: http://www.brucelindbloom.com/index.html?Eqn_Lab_to_XYZ.html
+func LabToXYZ(l float64, a float64, b float64, white WhitePoint) (x float64, y float64, z float64) {
+	x, y, z = labToXYZ(l, a, b, white.normalized())
+	return x * 100, y * 100, z * 100
+}
+
+// LabToLCh converts the color from CIELAB to CIELCh, the hue ranges from `0` to `360`.
+func LabToLCh(l float64, a float64, b float64) (float64, float64, float64) {
+	c, h := rectangularToPolar(a, b)
+	return l, c, h
+}
+
+// LChToLab converts the color from CIELCh to CIELAB.
+func LChToLab(l float64, c float64, h float64) (float64, float64, float64) {
+	a, b := polarToRectangular(c, h)
+	return l, a, b
+}
+
+// RGBToLab converts the color from RGB to CIELAB relative to the reference white, the sRGB (D65) color is adapted if the reference white is different.
+func RGBToLab(r float64, g float64, b float64, white WhitePoint) (float64, float64, float64) {
+	x, y, z := RGBToXYZ(r, g, b)
+	x, y, z = AdaptXYZ(x, y, z, D65, white)
+	return XYZToLab(x, y, z, white)
+}
+
+// LabToRGB converts the color from CIELAB relative to the reference white to RGB, the result can be out of the `0` - `255` range if the color is outside of the sRGB gamut.
+func LabToRGB(l float64, a float64, b float64, white WhitePoint) (float64, float64, float64) {
+	x, y, z := LabToXYZ(l, a, b, white)
+	x, y, z = AdaptXYZ(x, y, z, white, D65)
+	return XYZToRGB(x, y, z)
+}
+
+// NewXYZ initializes a color based on CIE XYZ (D65, `Y` ranges from `0` to `100`).
+func NewXYZ(x float64, y float64, z float64) Color {
+	r, g, b := XYZToRGB(x, y, z)
+	return newColor(r, g, b, 1)
+}
+
+// NewXYZA initializes a color based on CIE XYZ (D65, `Y` ranges from `0` to `100`) with an alpha channel.
+func NewXYZA(x float64, y float64, z float64, a float64) Color {
+	r, g, b := XYZToRGB(x, y, z)
+	return newColor(r, g, b, a)
+}
+
+// NewLab initializes a color based on CIELAB (D65), the same reference white as sRGB.
+// The CSS `lab()` function is relative to D50, use `NewLabWhite` with `D50` for the CSS values.
+func NewLab(l float64, a float64, b float64) Color {
+	r, g, bl := LabToRGB(l, a, b, D65)
+	return newColor(r, g, bl, 1)
+}
+
+// NewLabA initializes a color based on CIELAB (D65) with an alpha channel, use `NewLabWhite` with `D50` for the CSS `lab()` values.
+func NewLabA(l float64, a float64, b float64, alpha float64) Color {
+	r, g, bl := LabToRGB(l, a, b, D65)
+	return newColor(r, g, bl, alpha)
+}
+
+// NewLabWhite initializes a color based on CIELAB relative to the reference white.
+func NewLabWhite(l float64, a float64, b float64, white WhitePoint) Color {
+	r, g, bl := LabToRGB(l, a, b, white)
+	return newColor(r, g, bl, 1)
+}
+
+// NewLChWhite initializes a color based on CIELCh relative to the reference white.
+func NewLChWhite(l float64, c float64, h float64, white WhitePoint) Color {
+	l, a, b := LChToLab(l, c, h)
+	return NewLabWhite(l, a, b, white)
+}
+
+// NewLCh initializes a color based on CIELCh (D65), use `NewLChWhite` with `D50` for the CSS `lch()` values.
+func NewLCh(l float64, c float64, h float64) Color {
+	return NewLab(LChToLab(l, c, h))
+}
+
+// NewLChA initializes a color based on CIELCh (D65) with an alpha channel, use `NewLChWhite` with `D50` for the CSS `lch()` values.
+func NewLChA(l float64, c float64, h float64, a float64) Color {
+	l, x, y := LChToLab(l, c, h)
+	return NewLabA(l, x, y, a)
+}
+
+// XYZ returns the CIE XYZ (D65) value of the current color.
+func (c Color) XYZ() (float64, float64, float64) {
+	return RGBToXYZ(c.Red, c.Green, c.Blue)
+}
+
+// Lab returns the CIELAB (D65) value of the current color, the values are not rounded.
+// The CSS `lab()` function is relative to D50, use `LabWhite` with `D50` for the CSS values.
+func (c Color) Lab() (float64, float64, float64) {
+	return RGBToLab(c.Red, c.Green, c.Blue, D65)
+}
+
+// LabA returns the CIELAB (D65) value of the current color with the alpha channel, use `LabWhite` with `D50` for the CSS `lab()` values.
+func (c Color) LabA() (float64, float64, float64, float64) {
+	l, a, b := RGBToLab(c.Red, c.Green, c.Blue, D65)
+	return l, a, b, c.Alpha
+}
+
+// LabWhite returns the CIELAB value of the current color relative to the reference white.
+func (c Color) LabWhite(white WhitePoint) (float64, float64, float64) {
+	return RGBToLab(c.Red, c.Green, c.Blue, white)
+}
+
+// LCh returns the CIELCh (D65) value of the current color, the values are not rounded. Use `LChWhite` with `D50` for the CSS `lch()` values.
+func (c Color) LCh() (float64, float64, float64) {
+	return LabToLCh(c.Lab())
+}
+
+// LChA returns the CIELCh (D65) value of the current color with the alpha channel, use `LChWhite` with `D50` for the CSS `lch()` values.
+func (c Color) LChA() (float64, float64, float64, float64) {
+	l, ch, h := LabToLCh(c.Lab())
+	return l, ch, h, c.Alpha
+}
+
+// LChWhite returns the CIELCh value of the current color relative to the reference white.
+func (c Color) LChWhite(white WhitePoint) (float64, float64, float64) {
+	return LabToLCh(c.LabWhite(white))
+}
@@ -0,0 +1,132 @@
+package noire
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRGBToXYZ(t *testing.T) {
+	assert := assert.New(t)
+	x, y, z := RGBToXYZ(255, 255, 255)
+	assert.InDeltaSlice([]float64{95.046, 100, 108.906}, []float64{x, y, z}, 0.001)
+	x, y, z = RGBToXYZ(219, 112, 147)
+	assert.InDeltaSlice([]float64{40.273, 28.757, 31.034}, []float64{x, y, z}, 0.001)
+}
+
+func TestXYZToRGB(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := XYZToRGB(40.273, 28.757, 31.034)
+	assert.InDeltaSlice([]float64{219, 112, 147}, []float64{r, g, b}, 0.01)
+}
+
+func TestAdaptXYZ(t *testing.T) {
+	assert := assert.New(t)
+	x, y, z := AdaptXYZ(D65.X, D65.Y, D65.Z, D65, D50)
+	assert.InDeltaSlice([]float64{D50.X, D50.Y, D50.Z}, []float64{x, y, z}, 0.001)
+	x, y, z = AdaptXYZ(1, 2, 3, D65, D65)
+	assert.Equal([]float64{1, 2, 3}, []float64{x, y, z})
+}
+
+func TestXYZToLab(t *testing.T) {
+	assert := assert.New(t)
+	l, a, b := XYZToLab(D65.X, D65.Y, D65.Z, D65)
+	assert.InDeltaSlice([]float64{100, 0, 0}, []float64{l, a, b}, 1e-9)
+	l, a, b = XYZToLab(0, 0, 0, D65)
+	assert.InDeltaSlice([]float64{0, 0, 0}, []float64{l, a, b}, 1e-9)
+}
+
+func TestLabToXYZ(t *testing.T) {
+	assert := assert.New(t)
+	x, y, z := LabToXYZ(100, 0, 0, D50)
+	assert.InDeltaSlice([]float64{D50.X, D50.Y, D50.Z}, []float64{x, y, z}, 1e-9)
+	x, y, z = LabToXYZ(5, 10, -10, D65)
+	l, a, b := XYZToLab(x, y, z, D65)
+	assert.InDeltaSlice([]float64{5, 10, -10}, []float64{l, a, b}, 1e-9)
+}
+
+func TestLabToLCh(t *testing.T) {
+	assert := assert.New(t)
+	l, c, h := LabToLCh(50, 0, -20)
+	assert.InDeltaSlice([]float64{50, 20, 270}, []float64{l, c, h}, 1e-9)
+}
+
+func TestLChToLab(t *testing.T) {
+	assert := assert.New(t)
+	l, a, b := LChToLab(50, 20, 270)
+	assert.InDeltaSlice([]float64{50, 0, -20}, []float64{l, a, b}, 1e-9)
+}
+
+func TestRGBToLab(t *testing.T) {
+	assert := assert.New(t)
+	l, a, b := RGBToLab(255, 0, 0, D65)
+	assert.InDeltaSlice([]float64{53.24, 80.09, 67.20}, []float64{l, a, b}, 0.01)
+	l, a, b = RGBToLab(255, 0, 0, D50)
+	assert.InDeltaSlice([]float64{54.29, 80.80, 69.89}, []float64{l, a, b}, 0.01)
+}
+
+func TestLabToRGB(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := LabToRGB(54.29, 80.80, 69.89, D50)
+	assert.InDeltaSlice([]float64{255, 0, 0}, []float64{r, g, b}, 0.5)
+}
+
+func TestNewLab(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("FF0000", NewLab(53.24, 80.09, 67.20).Hex())
+	assert.Equal(0.5, NewLabA(53.24, 80.09, 67.20, 0.5).Alpha)
+	assert.Equal("FF0000", NewLabWhite(54.29, 80.80, 69.89, D50).Hex())
+	assert.Equal("FFFFFF", NewLab(120, 0, 0).Hex())
+}
+
+func TestNewLCh(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("FF0000", NewLCh(53.24, 104.55, 40).Hex())
+	assert.Equal(0.5, NewLChA(53.24, 104.55, 40, 0.5).Alpha)
+	assert.Equal("FF0000", NewLChWhite(54.29, 106.84, 40.85, D50).Hex())
+}
+
+func TestLabParseD50(t *testing.T) {
+	assert := assert.New(t)
+	for _, v := range [][3]float64{{54.29, 80.81, 69.89}, {60.91, 45.4, 1.21}, {30, -20, 40}} {
+		c, err := Parse(fmt.Sprintf("lab(%g %g %g)", v[0], v[1], v[2]))
+		assert.NoError(err)
+		w := NewLabWhite(v[0], v[1], v[2], D50)
+		assert.InDeltaSlice([]float64{w.Red, w.Green, w.Blue}, []float64{c.Red, c.Green, c.Blue}, 1e-9)
+	}
+}
+
+func TestNewXYZ(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("DB7093", NewXYZ(40.273, 28.757, 31.034).Hex())
+	assert.Equal(0.5, NewXYZA(40.273, 28.757, 31.034, 0.5).Alpha)
+}
+
+func TestLab(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	l, a, b := c.Lab()
+	assert.InDeltaSlice([]float64{60.57, 45.51, 0.40}, []float64{l, a, b}, 0.01)
+	l, a, b, alpha := c.LabA()
+	assert.InDeltaSlice([]float64{60.57, 45.51, 0.40, 1}, []float64{l, a, b, alpha}, 0.01)
+	l, a, b = c.LabWhite(D50)
+	assert.InDeltaSlice([]float64{60.91, 45.40, 1.21}, []float64{l, a, b}, 0.01)
+}
+
+func TestLCh(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(255, 0, 0)
+	l, ch, h := c.LCh()
+	assert.InDeltaSlice([]float64{53.24, 104.55, 40}, []float64{l, ch, h}, 0.01)
+	l, ch, h, a := c.LChA()
+	assert.InDeltaSlice([]float64{53.24, 104.55, 40, 1}, []float64{l, ch, h, a}, 0.01)
+	l, ch, h = c.LChWhite(D50)
+	assert.InDeltaSlice([]float64{54.29, 106.84, 40.85}, []float64{l, ch, h}, 0.01)
+}
+
+func TestXYZ(t *testing.T) {
+	assert := assert.New(t)
+	x, y, z := NewRGB(0, 0, 0).XYZ()
+	assert.Equal([]float64{0, 0, 0}, []float64{x, y, z})
+}
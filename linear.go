@@ -0,0 +1,83 @@
+package noire
+
+import "math"
+
+// SRGBToLinear converts a gamma encoded sRGB channel (`0` - `1`) to the linear light with the sRGB transfer function,
+// the negative values are mirrored so the colors outside of the sRGB gamut are kept.
+//
+// reference: https://www.w3.org/TR/css-color-4/#color-conversion-code
+func SRGBToLinear(v float64) float64 {
+	a := math.Abs(v)
+	if a <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Copysign(math.Pow((a+0.055)/1.055, 2.4), v)
+}
+
+// LinearToSRGB converts a linear light channel (`0` - `1`) to the gamma encoded sRGB with the sRGB transfer function, it's the inverse of `SRGBToLinear`.
+//
+// reference: https://www.w3.org/TR/css-color-4/#color-conversion-code
+func LinearToSRGB(v float64) float64 {
+	a := math.Abs(v)
+	if a <= 0.0031308 {
+		return v * 12.92
+	}
+	return math.Copysign(1.055*math.Pow(a, 1/2.4)-0.055, v)
+}
+
+// NewLinearRGB initializes a color based on the linear light RGB (`0` - `1`).
+func NewLinearRGB(r float64, g float64, b float64) Color {
+	r, g, b = linearToRGB(r, g, b)
+	return newColor(r, g, b, 1)
+}
+
+// NewLinearRGBA initializes a color based on the linear light RGB (`0` - `1`) with an alpha channel.
+func NewLinearRGBA(r float64, g float64, b float64, a float64) Color {
+	r, g, b = linearToRGB(r, g, b)
+	return newColor(r, g, b, a)
+}
+
+// Linear returns the linear light RGB (`0` - `1`) of the current color, the values are not rounded.
+func (c Color) Linear() (float64, float64, float64) {
+	return rgbToLinear(c.Red, c.Green, c.Blue)
+}
+
+// LinearA returns the linear light RGB (`0` - `1`) of the current color with the alpha channel.
+func (c Color) LinearA() (float64, float64, float64, float64) {
+	r, g, b := rgbToLinear(c.Red, c.Green, c.Blue)
+	return r, g, b, c.Alpha
+}
+
+// MixLinear mixs both color in the linear light with the specified weight of the second color (`0.5` as `50%`),
+// the alpha channel is weighted the same as `Mix` (the `mix()` function of Sass) unlike `MixIn` with `SpaceLinearSRGB` which uses the premultiplied alpha as CSS does.
+// Both are the same if the colors have the same alpha.
+func (c Color) MixLinear(color Color, weight float64) Color {
+	w1, alpha := mixWeight(c, color, weight)
+	w2 := 1 - w1
+	r1, g1, b1 := c.Linear()
+	r2, g2, b2 := color.Linear()
+	r, g, b := linearToRGB(w1*r1+w2*r2, w1*g1+w2*g2, w1*b1+w2*b2)
+	return newColor(r, g, b, alpha)
+}
+
+// TintIn increases the brightness of the color while keeping the color tone by mixing with a white color in the color space (`0.5` as `50%`),
+// `SpaceLinearSRGB` mixes the light physically. The white color has the same alpha as the current color, so the alpha handling is the same as `Tint`.
+func (c Color) TintIn(percent float64, space Space) Color {
+	return c.MixIn(newColor(255, 255, 255, c.Alpha), percent, space)
+}
+
+// ShadeIn decreases the brightness of the color while keeping the color tone by mixing with a black color in the color space (`0.5` as `50%`),
+// `SpaceLinearSRGB` mixes the light physically. The black color has the same alpha as the current color, so the alpha handling is the same as `Shade`.
+func (c Color) ShadeIn(percent float64, space Space) Color {
+	return c.MixIn(newColor(0, 0, 0, c.Alpha), percent, space)
+}
+
+// BrightenLinear increases the brightness of the color by adding the light to the linear light RGB channels (`0.5` as `50%`),
+// use a negative percent to decrease the brightness.
+func (c Color) BrightenLinear(percent float64) Color {
+	r, g, b := c.Linear()
+	r = math.Max(0, math.Min(1, r+percent))
+	g = math.Max(0, math.Min(1, g+percent))
+	b = math.Max(0, math.Min(1, b+percent))
+	return NewLinearRGBA(r, g, b, c.Alpha)
+}
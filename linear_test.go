@@ -0,0 +1,81 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSRGBToLinear(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0.0, SRGBToLinear(0))
+	assert.Equal(1.0, SRGBToLinear(1))
+	assert.InDelta(0.214041, SRGBToLinear(0.5), 1e-6)
+	assert.Equal(0.04/12.92, SRGBToLinear(0.04))
+	assert.Equal(-SRGBToLinear(0.5), SRGBToLinear(-0.5))
+}
+
+func TestLinearToSRGB(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0.0, LinearToSRGB(0))
+	assert.InDelta(1, LinearToSRGB(1), 1e-12)
+	assert.InDelta(0.735357, LinearToSRGB(0.5), 1e-6)
+	for _, v := range []float64{0.001, 0.04, 0.2, 0.7, 1.2} {
+		assert.InDelta(v, LinearToSRGB(SRGBToLinear(v)), 1e-12)
+	}
+}
+
+func TestNewLinearRGB(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("BCBCBC", NewLinearRGB(0.5, 0.5, 0.5).Hex())
+	assert.Equal(0.5, NewLinearRGBA(0.5, 0.5, 0.5, 0.5).Alpha)
+	assert.Equal("FFFFFF", NewLinearRGB(2, 2, 2).Hex())
+}
+
+func TestLinear(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	r, g, b := c.Linear()
+	assert.InDeltaSlice([]float64{0.708376, 0.162029, 0.291771}, []float64{r, g, b}, 1e-6)
+	r, g, b, a := NewRGBA(219, 112, 147, 0.5).LinearA()
+	assert.InDeltaSlice([]float64{0.708376, 0.162029, 0.291771, 0.5}, []float64{r, g, b, a}, 1e-6)
+	assert.Equal("DB7093", NewLinearRGB(c.Linear()).Hex())
+}
+
+func TestMixLinear(t *testing.T) {
+	assert := assert.New(t)
+	red, blue := NewHTML("Red"), NewHTML("Blue")
+	assert.Equal("BC00BC", red.MixLinear(blue, 0.5).Hex())
+	assert.Equal(red.MixIn(blue, 0.5, SpaceLinearSRGB).Hex(), red.MixLinear(blue, 0.5).Hex())
+
+	// The translucent colors are weighted like `Mix` (the opaque red gets 80%), unlike the premultiplied alpha of `MixIn`.
+	c := NewRGBA(255, 0, 0, 1).MixLinear(NewRGBA(0, 0, 255, 0.4), 0.5)
+	assert.InDelta(0.7, c.Alpha, 1e-9)
+	assert.InDeltaSlice([]float64{LinearToSRGB(0.8) * 255, 0, LinearToSRGB(0.2) * 255}, []float64{c.Red, c.Green, c.Blue}, 1e-9)
+	assert.Equal("E7007C", c.Hex())
+	assert.Equal("DC0092", NewRGBA(255, 0, 0, 1).MixIn(NewRGBA(0, 0, 255, 0.4), 0.5, SpaceLinearSRGB).Hex())
+}
+
+func TestTintIn(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	assert.Equal(c.Tint(0.5).Hex(), c.TintIn(0.5, SpaceSRGB).Hex())
+	assert.Equal("EEC9D2", c.TintIn(0.5, SpaceLinearSRGB).Hex())
+	assert.Equal(0.5, NewRGBA(219, 112, 147, 0.5).TintIn(0.5, SpaceLinearSRGB).Alpha)
+}
+
+func TestShadeIn(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	assert.Equal(c.Shade(0.5).Hex(), c.ShadeIn(0.5, SpaceSRGB).Hex())
+	assert.Equal("A1506B", c.ShadeIn(0.5, SpaceLinearSRGB).Hex())
+}
+
+func TestBrightenLinear(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	assert.Equal("E88CA8", c.BrightenLinear(0.1).Hex())
+	assert.Equal("CD4679", c.BrightenLinear(-0.1).Hex())
+	assert.Equal("FFFFFF", c.BrightenLinear(1).Hex())
+	assert.Equal("595959", NewRGB(0, 0, 0).BrightenLinear(0.1).Hex())
+}
@@ -0,0 +1,193 @@
+package noire
+
+import "math"
+
+// Space is the color space used to mix or interpolate the colors.
+type Space int
+
+const (
+	// SpaceSRGB mixes the gamma encoded RGB channels, it's the default space of the CSS `color-mix()` function for the legacy colors.
+	SpaceSRGB Space = iota
+	// SpaceLinearSRGB mixes the linear light RGB channels, it's physically correct but the midpoints look lighter.
+	SpaceLinearSRGB
+	// SpaceHSL mixes the HSL components, the hue goes around the color wheel.
+	SpaceHSL
+	// SpaceHSV mixes the HSV components, the hue goes around the color wheel.
+	SpaceHSV
+	// SpaceHWB mixes the HWB (Hue, Whiteness, Blackness) components, the hue goes around the color wheel.
+	SpaceHWB
+	// SpaceLab mixes the CIELAB (D65, the same as `Color.Lab`) components, CSS `lab` interpolates in D50 instead.
+	SpaceLab
+	// SpaceLCh mixes the CIELCh (D65, the same as `Color.LCh`) components, the hue goes around the color wheel. CSS `lch` interpolates in D50 instead.
+	SpaceLCh
+	// SpaceOKLab mixes the OKLab components, it's the default space of the CSS gradients and `color-mix()` for the modern colors.
+	SpaceOKLab
+	// SpaceOKLCH mixes the OKLCH components, the hue goes around the color wheel and the chroma is kept along the way.
+	SpaceOKLCH
+)
+
+// HueInterpolation is the way to interpolate the hue of the polar color spaces (`SpaceHSL`, `SpaceHSV`, `SpaceHWB`, `SpaceLCh` and `SpaceOKLCH`).
+//
+// reference: https://www.w3.org/TR/css-color-4/#hue-interpolation
+type HueInterpolation int
+
+const (
+	// HueShorter takes the shorter arc between the hues, the arc is never longer than 180 degrees.
+	HueShorter HueInterpolation = iota
+	// HueLonger takes the longer arc between the hues, the arc is never shorter than 180 degrees.
+	HueLonger
+	// HueIncreasing goes clockwise from the first hue to the second hue.
+	HueIncreasing
+	// HueDecreasing goes counterclockwise from the first hue to the second hue.
+	HueDecreasing
+)
+
+// hueIndex returns the index of the hue component, it's `-1` if the color space is not a polar space.
+func (s Space) hueIndex() int {
+	switch s {
+	case SpaceHSL, SpaceHSV, SpaceHWB:
+		return 0
+	case SpaceLCh, SpaceOKLCH:
+		return 2
+	}
+	return -1
+}
+
+// toSpace converts the RGB color to the components of the color space,
+// `powerless` is true if the hue doesn't affect the color (like the gray colors) so the hue of the other color should be used.
+func (s Space) toSpace(c Color) (v [3]float64, powerless bool) {
+	switch s {
+	case SpaceLinearSRGB:
+		v[0], v[1], v[2] = rgbToLinear(c.Red, c.Green, c.Blue)
+	case SpaceHSL:
+		v[0], v[1], v[2] = c.HSLExact()
+		powerless = v[1] < 1e-9
+	case SpaceHSV:
+		v[0], v[1], v[2] = c.HSVExact()
+		powerless = v[1] < 1e-9
+	case SpaceHWB:
+		h, sv, val := c.HSVExact()
+		v[0], v[1], v[2] = h, (100-sv)*val/100, 100-val
+		powerless = v[1]+v[2] > 100-1e-9
+	case SpaceLab:
+		v[0], v[1], v[2] = c.Lab()
+	case SpaceLCh:
+		v[0], v[1], v[2] = c.LCh()
+		powerless = v[1] < 1e-4
+	case SpaceOKLab:
+		v[0], v[1], v[2] = c.OKLab()
+	case SpaceOKLCH:
+		v[0], v[1], v[2] = c.OKLCH()
+		powerless = v[1] < 1e-6
+	default:
+		v[0], v[1], v[2] = c.Red, c.Green, c.Blue
+	}
+	return
+}
+
+// fromSpace converts the components of the color space to a color.
+func (s Space) fromSpace(v [3]float64, a float64) Color {
+	var r, g, b float64
+	switch s {
+	case SpaceLinearSRGB:
+		r, g, b = linearToRGB(v[0], v[1], v[2])
+	case SpaceHSL:
+		r, g, b = HSLToRGBExact(v[0], v[1], v[2])
+	case SpaceHSV:
+		r, g, b = HSVToRGBExact(v[0], v[1], v[2])
+	case SpaceHWB:
+		if v[1]+v[2] >= 100 {
+			gray := v[1] / (v[1] + v[2]) * 255
+			r, g, b = gray, gray, gray
+		} else {
+			val := 100 - v[2]
+			r, g, b = HSVToRGBExact(v[0], 100-v[1]/val*100, val)
+		}
+	case SpaceLab:
+		r, g, b = LabToRGB(v[0], v[1], v[2], D65)
+	case SpaceLCh:
+		l, x, y := LChToLab(v[0], v[1], v[2])
+		r, g, b = LabToRGB(l, x, y, D65)
+	case SpaceOKLab:
+		r, g, b = OKLabToRGB(v[0], v[1], v[2])
+	case SpaceOKLCH:
+		r, g, b = OKLabToRGB(OKLCHToOKLab(v[0], v[1], v[2]))
+	default:
+		r, g, b = v[0], v[1], v[2]
+	}
+	return newColor(r, g, b, a)
+}
+
+// fixupHues adjusts both hues (`0` - `360`) so the linear interpolation between them takes the specified arc.
+func fixupHues(h1 float64, h2 float64, method HueInterpolation) (float64, float64) {
+	d := h2 - h1
+	switch method {
+	case HueLonger:
+		if d > 0 && d < 180 {
+			h1 += 360
+		} else if d > -180 && d <= 0 {
+			h2 += 360
+		}
+	case HueIncreasing:
+		if d < 0 {
+			h2 += 360
+		}
+	case HueDecreasing:
+		if d > 0 {
+			h1 += 360
+		}
+	default:
+		if d > 180 {
+			h1 += 360
+		} else if d < -180 {
+			h2 += 360
+		}
+	}
+	return h1, h2
+}
+
+// Interpolate returns the color at `t` (from `0` to `1`) between both colors in the specified color space, the hue of the polar spaces is interpolated with the `hue` method.
+// The components are interpolated with the premultiplied alpha as CSS does (`PremultipliedColor.Lerp` in `SpaceSRGB`), and the hue of a gray color is taken from the other color.
+//
+// reference: https://www.w3.org/TR/css-color-4/#interpolation
+func Interpolate(from Color, to Color, t float64, space Space, hue HueInterpolation) Color {
+	a := from.Alpha + (to.Alpha-from.Alpha)*t
+	if space == SpaceSRGB && a != 0 {
+		return from.Premultiply().Lerp(to.Premultiply(), t).Unpremultiply()
+	}
+	v1, powerless1 := space.toSpace(from)
+	v2, powerless2 := space.toSpace(to)
+	hi := space.hueIndex()
+	if hi != -1 {
+		if powerless1 && !powerless2 {
+			v1[hi] = v2[hi]
+		} else if powerless2 && !powerless1 {
+			v2[hi] = v1[hi]
+		}
+		v1[hi], v2[hi] = fixupHues(v1[hi], v2[hi], hue)
+	}
+
+	if a != 0 {
+		v1, v2 = premultiply(v1, from.Alpha, hi), premultiply(v2, to.Alpha, hi)
+	}
+	var v [3]float64
+	for i := range v {
+		v[i] = v1[i] + (v2[i]-v1[i])*t
+	}
+	if a != 0 {
+		v = unpremultiply(v, a, hi)
+	}
+	if hi != -1 {
+		v[hi] = math.Mod(v[hi], 360)
+	}
+	return space.fromSpace(v, a)
+}
+
+// MixIn mixs both color in the specified color space with the weight of the second color (`0.5` as `50%`), the same as the CSS `color-mix()` function.
+// The channels are mixed with the premultiplied alpha, so a translucent color affects the result less than an opaque one,
+// and mixing with `transparent` only fades the color instead of darkening it. The hue of the polar spaces takes the shorter arc.
+//
+// reference: https://www.w3.org/TR/css-color-5/#color-mix
+func (c Color) MixIn(color Color, weight float64, space Space) Color {
+	return Interpolate(c, color, weight, space, HueShorter)
+}
@@ -0,0 +1,88 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMixAlpha(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGBA(255, 0, 0, 1).Mix(NewRGBA(0, 0, 255, 0.4), 0.5)
+	assert.InDelta(0.7, c.Alpha, 1e-9)
+	assert.InDeltaSlice([]float64{204, 0, 51}, []float64{c.Red, c.Green, c.Blue}, 1e-9)
+
+	c = NewRGBA(255, 0, 0, 0.5).Mix(NewRGBA(0, 0, 255, 0.5), 0.25)
+	assert.Equal(0.5, c.Alpha)
+	assert.InDeltaSlice([]float64{191.25, 0, 63.75}, []float64{c.Red, c.Green, c.Blue}, 1e-9)
+
+	c = NewRGBA(255, 0, 0, 1).Mix(NewRGBA(0, 0, 255, 0), 1)
+	assert.Equal(0.0, c.Alpha)
+	assert.Equal("0000FF", c.Hex())
+}
+
+func TestMixIn(t *testing.T) {
+	assert := assert.New(t)
+	c := NewHTML("Red").MixIn(NewHTML("Blue"), 0.5, SpaceSRGB)
+	assert.Equal("800080", c.Hex())
+	c = NewHTML("Red").MixIn(NewHTML("Blue"), 0.5, SpaceLinearSRGB)
+	assert.Equal("BC00BC", c.Hex())
+
+	c = NewHTML("Red").MixIn(NewRGBA(0, 0, 0, 0), 0.5, SpaceSRGB)
+	assert.Equal("FF0000", c.Hex())
+	assert.Equal(0.5, c.Alpha)
+
+	c = NewRGBA(255, 0, 0, 1).MixIn(NewRGBA(0, 0, 255, 0.4), 0.5, SpaceSRGB)
+	assert.InDelta(0.7, c.Alpha, 1e-9)
+	assert.InDeltaSlice([]float64{182.143, 0, 72.857}, []float64{c.Red, c.Green, c.Blue}, 1e-3)
+
+	c = NewRGBA(255, 0, 0, 0).MixIn(NewRGBA(0, 0, 255, 0), 0.5, SpaceSRGB)
+	assert.Equal(0.0, c.Alpha)
+	assert.Equal("800080", c.Hex())
+}
+
+func TestMixInSpaces(t *testing.T) {
+	assert := assert.New(t)
+	red, blue := NewHTML("Red"), NewHTML("Blue")
+	assert.Equal("FF00FF", red.MixIn(blue, 0.5, SpaceHSL).Hex())
+	assert.Equal("FF00FF", red.MixIn(blue, 0.5, SpaceHSV).Hex())
+	assert.Equal("FF00FF", red.MixIn(blue, 0.5, SpaceHWB).Hex())
+	assert.Equal("CA0088", red.MixIn(blue, 0.5, SpaceLab).Hex())
+	assert.Equal("FA0080", red.MixIn(blue, 0.5, SpaceLCh).Hex())
+	assert.Equal("8C53A2", red.MixIn(blue, 0.5, SpaceOKLab).Hex())
+	assert.Equal("BA00C2", red.MixIn(blue, 0.5, SpaceOKLCH).Hex())
+
+	// The hue of a gray color is powerless and takes the hue of the other color.
+	assert.Equal("9F9FDF", NewHTML("White").MixIn(blue, 0.5, SpaceHSL).Hex())
+	assert.Equal("800000", NewHTML("Black").MixIn(red, 0.5, SpaceHWB).Hex())
+	assert.Equal("74A3FF", NewHTML("White").MixIn(blue, 0.5, SpaceOKLCH).Hex())
+}
+
+func TestInterpolate(t *testing.T) {
+	assert := assert.New(t)
+	red, blue := NewHTML("Red"), NewHTML("Blue")
+	assert.Equal("FF0000", Interpolate(red, blue, 0, SpaceOKLab, HueShorter).Hex())
+	assert.Equal("0000FF", Interpolate(red, blue, 1, SpaceOKLab, HueShorter).Hex())
+	assert.Equal("FF00FF", Interpolate(red, blue, 0.5, SpaceHSL, HueShorter).Hex())
+	assert.Equal("00FF00", Interpolate(red, blue, 0.5, SpaceHSL, HueLonger).Hex())
+	assert.Equal("00FF00", Interpolate(red, blue, 0.5, SpaceHSL, HueIncreasing).Hex())
+	assert.Equal("FF00FF", Interpolate(red, blue, 0.5, SpaceHSL, HueDecreasing).Hex())
+	assert.Equal("009300", Interpolate(red, blue, 0.5, SpaceOKLCH, HueLonger).Hex())
+
+	c := Interpolate(NewHSLA(350, 100, 50, 1), NewHSLA(10, 100, 50, 0.5), 0.5, SpaceHSL, HueShorter)
+	h, _, _ := c.HSLExact()
+	assert.InDelta(0, h, 1e-9)
+	assert.Equal(0.75, c.Alpha)
+}
+
+func TestFixupHues(t *testing.T) {
+	assert := assert.New(t)
+	h1, h2 := fixupHues(350, 10, HueShorter)
+	assert.Equal([]float64{350, 370}, []float64{h1, h2})
+	h1, h2 = fixupHues(350, 10, HueLonger)
+	assert.Equal([]float64{350, 10}, []float64{h1, h2})
+	h1, h2 = fixupHues(10, 350, HueIncreasing)
+	assert.Equal([]float64{10, 350}, []float64{h1, h2})
+	h1, h2 = fixupHues(10, 350, HueDecreasing)
+	assert.Equal([]float64{370, 350}, []float64{h1, h2})
+}
@@ -0,0 +1,264 @@
+package noire
+
+//go:generate go run gen_xkcd.go
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// namedColor is a color name with its Hex string (without the `#` prefix).
+type namedColor struct {
+	name string
+	hex  string
+}
+
+// ErrReadOnlyPalette is returned when registering a name to a built-in palette, use `Clone` to get a palette that can be modified.
+var ErrReadOnlyPalette = errors.New("noire: read-only palette")
+
+// Palette is a registry of the color names, the names are case-insensitive and the whitespaces are ignored (`Sky Blue` is the same as `skyblue`).
+// It's safe to use a palette concurrently.
+type Palette struct {
+	mu       sync.RWMutex
+	names    map[string]string
+	hexes    map[string][]string
+	readOnly bool
+}
+
+// cssPalette is the built-in table of the CSS named colors used by the package functions, so reassigning `CSS` doesn't affect them.
+var cssPalette = newPalette(cssColors)
+
+var (
+	// CSS is the read-only palette of the CSS named colors, the same names used by `NewHTML`, `HTMLToRGB`, `RGBToHTML`, `Parse` and `Color.NearestName`.
+	// Use a clone of it (or `NewPalette`) for the custom names, `Parse` only matches the CSS names case-insensitively without the whitespaces.
+	CSS = cssPalette
+	// X11 is the read-only palette of the X11 named colors, some of the colors are different from CSS (like: `Gray`, `Green`, `Maroon` and `Purple`).
+	X11 = newPalette(x11Colors)
+	// XKCDCommon is the read-only palette of the most common names from the xkcd color survey, it's a small subset instead of the full 949 survey colors.
+	// Register more names to a clone of it for the other survey colors, or run `go generate` for the full list (see `gen_xkcd.go`).
+	XKCDCommon = newPalette(xkcdColors)
+)
+
+// NewPalette creates an empty palette.
+func NewPalette() *Palette {
+	return &Palette{
+		names: make(map[string]string),
+		hexes: make(map[string][]string),
+	}
+}
+
+// newPalette creates a read-only palette with the built-in colors.
+func newPalette(colors []namedColor) *Palette {
+	p := NewPalette()
+	for _, v := range colors {
+		p.register(v.name, v.hex)
+	}
+	p.readOnly = true
+	return p
+}
+
+// normalizeName uppercases the color name and removes the whitespaces.
+func normalizeName(name string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(name), ""))
+}
+
+// register adds the name of the uppercased Hex string (without the `#` prefix) to the palette, the name is replaced if it exists.
+func (p *Palette) register(name string, hex string) {
+	key := normalizeName(name)
+	if old, ok := p.names[key]; ok {
+		names := p.hexes[old]
+		for i, v := range names {
+			if normalizeName(v) == key {
+				names = append(names[:i:i], names[i+1:]...)
+				break
+			}
+		}
+		if len(names) == 0 {
+			delete(p.hexes, old)
+		} else {
+			p.hexes[old] = names
+		}
+	}
+	p.names[key] = hex
+	p.hexes[hex] = append(p.hexes[hex], name)
+}
+
+// Register adds a color name with a Hex string (can be `#` prefixed or either a 3 characters shorthand) to the palette,
+// the existing name will be replaced. It returns an error if the Hex string is invalid or `ErrReadOnlyPalette` if it's a built-in palette (like: `CSS`).
+func (p *Palette) Register(name string, hex string) error {
+	if p.readOnly {
+		return ErrReadOnlyPalette
+	}
+	r, g, b, err := parseHex("Register", hex)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.register(name, RGBToHex(r, g, b))
+	return nil
+}
+
+// Clone returns a copy of the palette that can be modified, so the names can be registered without modifying the original one.
+func (p *Palette) Clone() *Palette {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	c := NewPalette()
+	for k, v := range p.names {
+		c.names[k] = v
+	}
+	for k, v := range p.hexes {
+		c.hexes[k] = append([]string(nil), v...)
+	}
+	return c
+}
+
+// Len returns the count of the names in the palette.
+func (p *Palette) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.names)
+}
+
+// lookup returns the uppercased Hex string (without the `#` prefix) of the color name.
+func (p *Palette) lookup(name string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	h, ok := p.names[normalizeName(name)]
+	return h, ok
+}
+
+// lookupExact is like `lookup` but the name must not contain the whitespaces, so it only matches the names case-insensitively like the CSS keywords.
+func (p *Palette) lookupExact(name string) (string, bool) {
+	if strings.IndexFunc(name, unicode.IsSpace) != -1 {
+		return "", false
+	}
+	return p.lookup(name)
+}
+
+// parseHTML parses the color name or a `#` prefixed Hex string to RGB.
+func (p *Palette) parseHTML(fn string, h string) (r float64, g float64, b float64, err error) {
+	if strings.HasPrefix(h, "#") {
+		return parseHex(fn, h)
+	}
+	v, ok := p.lookup(h)
+	if !ok {
+		err = &ParseError{Func: fn, Input: h, Pos: 0, Err: ErrUnknownName}
+		return
+	}
+	return parseHex(fn, v)
+}
+
+// HTMLToRGB converts the color from the color name of the palette or a `#` prefixed Hex string to RGB, it returns a black color if the name is unknown.
+func (p *Palette) HTMLToRGB(h string) (r float64, g float64, b float64) {
+	r, g, b, _ = p.parseHTML("HTMLToRGB", h)
+	return
+}
+
+// RGBToHTML converts the color from RGB to the first registered color name of the palette, or a `#` prefixed Hex string if it doesn't have a name.
+func (p *Palette) RGBToHTML(r float64, g float64, b float64) string {
+	h := RGBToHex(r, g, b)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if names, ok := p.hexes[h]; ok {
+		return names[0]
+	}
+	return "#" + h
+}
+
+// NewHTML initializes a color based on the color name of the palette, it's a black color if the name is unknown (see `ParseHTML`).
+func (p *Palette) NewHTML(color string) Color {
+	r, g, b := p.HTMLToRGB(color)
+	return newColor(r, g, b, 1)
+}
+
+// NewHTMLA initializes a color based on the color name of the palette with an alpha channel, it's a black color if the name is unknown (see `ParseHTMLA`).
+func (p *Palette) NewHTMLA(color string, a float64) Color {
+	r, g, b := p.HTMLToRGB(color)
+	return newColor(r, g, b, a)
+}
+
+// ParseHTML initializes a color based on the color name of the palette or a `#` prefixed Hex string, it returns an error if the name is unknown.
+func (p *Palette) ParseHTML(color string) (Color, error) {
+	r, g, b, err := p.parseHTML("ParseHTML", color)
+	if err != nil {
+		return Color{}, err
+	}
+	return newColor(r, g, b, 1), nil
+}
+
+// ParseHTMLA initializes a color based on the color name of the palette or a `#` prefixed Hex string with an alpha channel, it returns an error if the name is unknown.
+func (p *Palette) ParseHTMLA(color string, a float64) (Color, error) {
+	r, g, b, err := p.parseHTML("ParseHTMLA", color)
+	if err != nil {
+		return Color{}, err
+	}
+	return newColor(r, g, b, a), nil
+}
+
+// Names returns all the names (including the aliases, like: `Aqua` and `Cyan`) of the color in the palette.
+func (p *Palette) Names(c Color) []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]string(nil), p.hexes[c.Hex()]...)
+}
+
+// NameMatch is a color name with its distance to the compared color.
+type NameMatch struct {
+	Name     string
+	Color    Color
+	Distance float64
+}
+
+// NearestName returns the closest color name of the palette with its CIEDE2000 distance, the distance is `0` if it's an exact match.
+func (p *Palette) NearestName(c Color) (string, float64) {
+	m := p.NearestNames(c, 1)
+	if len(m) == 0 {
+		return "", 0
+	}
+	return m[0].Name, m[0].Distance
+}
+
+// NearestNames returns the `n` closest color names of the palette sorted by the CIEDE2000 distance, the aliases are not included.
+// It returns nil if `n` is not positive.
+func (p *Palette) NearestNames(c Color, n int) []NameMatch {
+	if n <= 0 {
+		return nil
+	}
+	l1, a1, b1 := c.Lab()
+	p.mu.RLock()
+	matches := make([]NameMatch, 0, len(p.hexes))
+	for h, names := range p.hexes {
+		r, g, b := HexToRGB(h)
+		l2, a2, b2 := RGBToLab(r, g, b, D65)
+		matches = append(matches, NameMatch{
+			Name:     names[0],
+			Color:    newColor(r, g, b, 1),
+			Distance: deltaE2000(l1, a1, b1, l2, a2, b2),
+		})
+	}
+	p.mu.RUnlock()
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance == matches[j].Distance {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].Distance < matches[j].Distance
+	})
+	if n < len(matches) {
+		matches = matches[:n]
+	}
+	return matches
+}
+
+// NearestName returns the closest HTML color name of the current color with its CIEDE2000 distance, the distance is `0` if it's an exact match.
+func (c Color) NearestName() (string, float64) {
+	return cssPalette.NearestName(c)
+}
+
+// NearestNames returns the `n` closest HTML color names of the current color sorted by the CIEDE2000 distance, it returns nil if `n` is not positive.
+func (c Color) NearestNames(n int) []NameMatch {
+	return cssPalette.NearestNames(c, n)
+}
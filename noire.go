@@ -49,6 +49,15 @@ func newColor(r float64, g float64, b float64, a float64) Color {
 //
 // reference: https://www.ginifab.com.tw/tools/colors/js/colorconverter.js
 func CMYKToRGB(c float64, m float64, y float64, k float64) (r float64, g float64, b float64) {
+	r, g, b = CMYKToRGBExact(c, m, y, k)
+	r = math.Round(r)
+	g = math.Round(g)
+	b = math.Round(b)
+	return
+}
+
+// CMYKToRGBExact converts the color from CMYK to RGB without rounding the result.
+func CMYKToRGBExact(c float64, m float64, y float64, k float64) (r float64, g float64, b float64) {
 	c = c / 100
 	m = m / 100
 	y = y / 100
@@ -58,9 +67,9 @@ func CMYKToRGB(c float64, m float64, y float64, k float64) (r float64, g float64
 	g = 1 - math.Min(1, m*(1-k)+k)
 	b = 1 - math.Min(1, y*(1-k)+k)
 
-	r = math.Round(r * 255)
-	g = math.Round(g * 255)
-	b = math.Round(b * 255)
+	r = r * 255
+	g = g * 255
+	b = b * 255
 
 	return
 }
@@ -69,6 +78,16 @@ func CMYKToRGB(c float64, m float64, y float64, k float64) (r float64, g float64
 //
 // reference: https://www.ginifab.com.tw/tools/colors/js/colorconverter.js
 func RGBToCMYK(r float64, g float64, b float64) (c float64, m float64, y float64, k float64) {
+	c, m, y, k = RGBToCMYKExact(r, g, b)
+	c = math.Round(c)
+	m = math.Round(m)
+	y = math.Round(y)
+	k = math.Round(k)
+	return
+}
+
+// RGBToCMYKExact converts the color from RGB to CMYK without rounding the result.
+func RGBToCMYKExact(r float64, g float64, b float64) (c float64, m float64, y float64, k float64) {
 	r = r / 255
 	g = g / 255
 	b = b / 255
@@ -84,10 +103,10 @@ func RGBToCMYK(r float64, g float64, b float64) (c float64, m float64, y float64
 		m = (1 - g - k) / (1 - k)
 		y = (1 - b - k) / (1 - k)
 	}
-	c = math.Round(c * 100)
-	m = math.Round(m * 100)
-	y = math.Round(y * 100)
-	k = math.Round(k * 100)
+	c = c * 100
+	m = m * 100
+	y = y * 100
+	k = k * 100
 	return
 }
 
@@ -118,50 +137,81 @@ func HueToRGB(p float64, q float64, t float64) float64 {
 //
 // reference: https://www.ginifab.com.tw/tools/colors/js/colorconverter.js
 func RGBToHSL(r float64, g float64, b float64) (h float64, s float64, l float64) {
-	r = r / 255
-	g = g / 255
-	b = b / 255
-	max := math.Max(r, g)
-	max = math.Max(max, b)
-	min := math.Min(r, g)
-	min = math.Min(min, b)
-	l = (max + min) / 2
-	if max == min {
-		h = 0
-		s = 0
-	} else {
-		d := max - min
-		if l > 0.5 {
-			s = d / (2 - max - min)
-		} else {
-			s = d / (max + min)
-		}
-		switch max {
-		case r:
-			if g < b {
-				h = (g-b)/d + 6
+	h, s, l = RGBToHSLExact(r, g, b)
+	h = math.Round(h)
+	s = math.Round(s*10) / 10
+	l = math.Round(l*10) / 10
+	return
+}
+
+// RGBToHSLExact converts the color from RGB to HSL without rounding the result.
+func RGBToHSLExact(r float64, g float64, b float64) (h float64, s float64, l float64) {
+	v := [3]float64{r, g, b}
+	rgbToHSL(v[:], v[:])
+	return v[0], v[1], v[2]
+}
+
+// minMax3 returns the minimum and the maximum of the channels, it compares the values directly since `math.Min` and `math.Max` are slower for the hot loops.
+func minMax3(r float64, g float64, b float64) (min float64, max float64) {
+	min, max = r, r
+	if g < min {
+		min = g
+	} else if g > max {
+		max = g
+	}
+	if b < min {
+		min = b
+	} else if b > max {
+		max = b
+	}
+	return
+}
+
+// rgbToHSL converts the interleaved RGB triplets of `src` to the HSL triplets of `dst` (both have the same length of a multiple of `3`) without rounding the results,
+// it's the only implementation of the conversion for both `RGBToHSLExact` and `ConvertRGBToHSLExact` so the loop doesn't call a function for every triplet.
+func rgbToHSL(dst []float64, src []float64) {
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		v, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		r, g, b := v[0]/255, v[1]/255, v[2]/255
+		min, max := minMax3(r, g, b)
+		var h, s float64
+		l := (max + min) / 2
+		if max != min {
+			delta := max - min
+			if l > 0.5 {
+				s = delta / (2 - max - min)
 			} else {
-				h = (g-b)/d + 0
+				s = delta / (max + min)
+			}
+			switch max {
+			case r:
+				h = (g - b) / delta
+				if g < b {
+					h += 6
+				}
+			case g:
+				h = (b-r)/delta + 2
+			default:
+				h = (r-g)/delta + 4
 			}
-			break
-		case g:
-			h = (b-r)/d + 2
-			break
-		case b:
-			h = (r-g)/d + 4
-			break
 		}
+		d[0], d[1], d[2] = h*60, s*100, l*100
 	}
-	h = math.Round(h * 60)
-	s = math.Round(s*1000) / 10
-	l = math.Round(l*1000) / 10
-	return
 }
 
 // HSLToRGB converts the color from HSL to RGB with a lossy algorithm.
 //
 // reference: https://www.ginifab.com.tw/tools/colors/js/colorconverter.js
 func HSLToRGB(h float64, s float64, l float64) (r float64, g float64, b float64) {
+	r, g, b = HSLToRGBExact(h, s, l)
+	r = math.Round(r)
+	g = math.Round(g)
+	b = math.Round(b)
+	return
+}
+
+// HSLToRGBExact converts the color from HSL to RGB without rounding the result.
+func HSLToRGBExact(h float64, s float64, l float64) (r float64, g float64, b float64) {
 	h = h / 360
 	s = s / 100
 	l = l / 100
@@ -182,9 +232,9 @@ func HSLToRGB(h float64, s float64, l float64) (r float64, g float64, b float64)
 		g = HueToRGB(p, q, h)
 		b = HueToRGB(p, q, h-float64(float64(1)/float64(3)))
 	}
-	r = math.Round(r * 255)
-	g = math.Round(g * 255)
-	b = math.Round(b * 255)
+	r = r * 255
+	g = g * 255
+	b = b * 255
 	return
 }
 
@@ -192,6 +242,15 @@ func HSLToRGB(h float64, s float64, l float64) (r float64, g float64, b float64)
 //
 // reference: https://www.rapidtables.com/convert/color/hsv-to-rgb.html
 func HSVToRGB(h float64, s float64, v float64) (r float64, g float64, b float64) {
+	r, g, b = HSVToRGBExact(h, s, v)
+	r = math.Round(r)
+	g = math.Round(g)
+	b = math.Round(b)
+	return
+}
+
+// HSVToRGBExact converts the color from HSV to RGB without rounding the result.
+func HSVToRGBExact(h float64, s float64, v float64) (r float64, g float64, b float64) {
 	s = s / 100
 	v = v / 100
 	c := v * s
@@ -220,9 +279,9 @@ func HSVToRGB(h float64, s float64, v float64) (r float64, g float64, b float64)
 	r += m
 	g += m
 	b += m
-	r = math.Round(r * 255)
-	g = math.Round(g * 255)
-	b = math.Round(b * 255)
+	r = r * 255
+	g = g * 255
+	b = b * 255
 	return
 }
 
@@ -230,47 +289,51 @@ func HSVToRGB(h float64, s float64, v float64) (r float64, g float64, b float64)
 //
 // reference: https://www.ginifab.com.tw/tools/colors/js/colorconverter.js
 func RGBToHSV(r float64, g float64, b float64) (h float64, s float64, v float64) {
-	r = r / 255
-	g = g / 255
-	b = b / 255
-
-	minValue := math.Min(math.Min(r, g), b)
-	maxValue := math.Max(math.Max(r, g), b)
-
-	delta := maxValue - minValue
-	v = maxValue
+	h, s, v = RGBToHSVExact(r, g, b)
+	h = math.Round(h)
+	s = math.Round(s*10) / 10
+	v = math.Round(v*10) / 10
+	return
+}
 
-	if delta == 0 {
-		h = 0
-		s = 0
-	} else {
-		s = delta / maxValue
-		deltaR := (((maxValue - r) / 6) + (delta / 2)) / delta
-		deltaG := (((maxValue - g) / 6) + (delta / 2)) / delta
-		deltaB := (((maxValue - b) / 6) + (delta / 2)) / delta
-		switch maxValue {
-		case r:
-			h = deltaB - deltaG
-			break
-		case g:
-			h = (float64(1) / float64(3)) + deltaR - deltaB
-			break
-		case b:
-			h = (float64(2) / float64(3)) + deltaG - deltaR
-			break
-		}
-		if h < 0 {
-			h++
-		}
-		if h > 1 {
-			h--
+// RGBToHSVExact converts the color from RGB to HSV without rounding the result.
+func RGBToHSVExact(r float64, g float64, b float64) (h float64, s float64, v float64) {
+	c := [3]float64{r, g, b}
+	rgbToHSV(c[:], c[:])
+	return c[0], c[1], c[2]
+}
+
+// rgbToHSV converts the interleaved RGB triplets of `src` to the HSV triplets of `dst` (see `rgbToHSL`),
+// it's the only implementation of the conversion for both `RGBToHSVExact` and `ConvertRGBToHSVExact`.
+func rgbToHSV(dst []float64, src []float64) {
+	for i := 0; i < len(src) && i < len(dst); i += 3 {
+		c, d := src[i:i+3:i+3], dst[i:i+3:i+3]
+		r, g, b := c[0]/255, c[1]/255, c[2]/255
+		minValue, maxValue := minMax3(r, g, b)
+		var h, s float64
+		delta := maxValue - minValue
+		if delta != 0 {
+			s = delta / maxValue
+			deltaR := (((maxValue - r) / 6) + (delta / 2)) / delta
+			deltaG := (((maxValue - g) / 6) + (delta / 2)) / delta
+			deltaB := (((maxValue - b) / 6) + (delta / 2)) / delta
+			switch maxValue {
+			case r:
+				h = deltaB - deltaG
+			case g:
+				h = (float64(1) / float64(3)) + deltaR - deltaB
+			default:
+				h = (float64(2) / float64(3)) + deltaG - deltaR
+			}
+			if h < 0 {
+				h++
+			}
+			if h > 1 {
+				h--
+			}
 		}
+		d[0], d[1], d[2] = h*360, s*100, maxValue*100
 	}
-
-	h = math.Round(h * 360)
-	s = math.Round(s*1000) / 10
-	v = math.Round(v*1000) / 10
-	return
 }
 
 // RGBToHex converts the color from RGB to a uppercased Hex string (without the `#` prefix).
@@ -279,70 +342,45 @@ func RGBToHex(r float64, g float64, b float64) string {
 	return strings.ToUpper(hex.EncodeToString(h))
 }
 
-// HexToRGB converts the Hex string (can be `#` prefixed or either a 3 characters shorthand) to RGB.
+// HexToRGB converts the Hex string (can be `#` prefixed or either a 3 characters shorthand) to RGB,
+// the 4 and 8 digits forms are accepted and the alpha channel is ignored (like: `DB709380` is `DB7093`).
+// It returns a black color if the string is invalid, use `ParseHex` to get the error instead.
 func HexToRGB(h string) (r float64, g float64, b float64) {
-	if string(h[0]) == "#" {
-		h = h[1:]
-	}
-	if len(h) == 3 {
-		h = string(h[0]) + string(h[0]) + string(h[1]) + string(h[1]) + string(h[2]) + string(h[2])
-	}
-	byteArray, _ := hex.DecodeString(h)
-	r = float64(byteArray[0])
-	g = float64(byteArray[1])
-	b = float64(byteArray[2])
+	r, g, b, _, _ = parseHexAlpha("HexToRGB", h, true)
 	return
 }
 
 // HTMLToRGB converts the color from HTML color name or a Hex string (can be `#` prefixed or either a 3 characters shorthand) to RGB.
+// It returns a black color if the name is unknown, use `ParseHTML` to get the error instead.
 func HTMLToRGB(h string) (r float64, g float64, b float64) {
-	if string(h[0]) == "#" {
-		h = h[1:]
-		r, g, b = HexToRGB(h)
-	} else {
-		v, ok := colorNames[strings.ToUpper(h)]
-		if !ok {
-			r = 0
-			g = 0
-			b = 0
-			return
-		}
-		r, g, b = HexToRGB(v)
-	}
+	r, g, b, _ = cssPalette.parseHTML("HTMLToRGB", h)
 	return
 }
 
 // RGBToHTML converts the color from RGB to a `#` prefixed Hex string if it doesn't have a HTML color name.
-func RGBToHTML(r float64, g float64, b float64) (h string) {
-	h = RGBToHex(r, g, b)
-	v, ok := hexNames[h]
-	if !ok {
-		h = "#" + h
-		return
-	}
-	h = v
-	return
+func RGBToHTML(r float64, g float64, b float64) string {
+	return cssPalette.RGBToHTML(r, g, b)
 }
 
-// NewHTML initializes a color based on the HTML color name.
+// NewHTML initializes a color based on the HTML color name, it's a black color if the name is unknown (see `ParseHTML`).
 func NewHTML(color string) Color {
 	r, g, b := HTMLToRGB(color)
 	return newColor(r, g, b, 1)
 }
 
-// NewHTMLA initializes a color based on the HTML color name with an alpha channel.
+// NewHTMLA initializes a color based on the HTML color name with an alpha channel, it's a black color if the name is unknown (see `ParseHTMLA`).
 func NewHTMLA(color string, a float64) Color {
 	r, g, b := HTMLToRGB(color)
 	return newColor(r, g, b, a)
 }
 
-// NewHex initializes a color based on a Hex string.
+// NewHex initializes a color based on a Hex string (the alpha channel of the 4 and 8 digits forms is ignored, see `HexToRGB`), it's a black color if the string is invalid (see `ParseHex`).
 func NewHex(color string) Color {
 	r, g, b := HexToRGB(color)
 	return newColor(r, g, b, 1)
 }
 
-// NewHexA initializes a color based on a Hex string with an alpha channel.
+// NewHexA initializes a color based on a Hex string with an alpha channel, it's a black color if the string is invalid (see `ParseHexA`).
 func NewHexA(color string, a float64) Color {
 	r, g, b := HexToRGB(color)
 	return newColor(r, g, b, a)
@@ -360,6 +398,18 @@ func NewHSLA(h float64, s float64, l float64, a float64) Color {
 	return newColor(r, g, b, a)
 }
 
+// NewHSLExact initializes a color based on HSL without rounding the RGB channels.
+func NewHSLExact(h float64, s float64, l float64) Color {
+	r, g, b := HSLToRGBExact(h, s, l)
+	return newColor(r, g, b, 1)
+}
+
+// NewHSLAExact initializes a color based on HSL with an alpha channel without rounding the RGB channels.
+func NewHSLAExact(h float64, s float64, l float64, a float64) Color {
+	r, g, b := HSLToRGBExact(h, s, l)
+	return newColor(r, g, b, a)
+}
+
 // NewHSV initializes a color based on HSV.
 func NewHSV(h float64, s float64, v float64) Color {
 	r, g, b := HSVToRGB(h, s, v)
@@ -372,6 +422,18 @@ func NewHSVA(h float64, s float64, v float64, a float64) Color {
 	return newColor(r, g, b, a)
 }
 
+// NewHSVExact initializes a color based on HSV without rounding the RGB channels.
+func NewHSVExact(h float64, s float64, v float64) Color {
+	r, g, b := HSVToRGBExact(h, s, v)
+	return newColor(r, g, b, 1)
+}
+
+// NewHSVAExact initializes a color based on HSV with an alpha channel without rounding the RGB channels.
+func NewHSVAExact(h float64, s float64, v float64, a float64) Color {
+	r, g, b := HSVToRGBExact(h, s, v)
+	return newColor(r, g, b, a)
+}
+
 // NewRGB initializes a color based on RGB.
 func NewRGB(r float64, g float64, b float64) Color {
 	return newColor(r, g, b, 1)
@@ -394,14 +456,44 @@ func NewCMYKA(c float64, m float64, y float64, k float64, a float64) Color {
 	return newColor(r, g, b, a)
 }
 
+// NewCMYKExact initializes a color based on CMYK without rounding the RGB channels.
+func NewCMYKExact(c float64, m float64, y float64, k float64) Color {
+	r, g, b := CMYKToRGBExact(c, m, y, k)
+	return newColor(r, g, b, 1)
+}
+
+// NewCMYKAExact initializes a color based on CMYK with an alpha channel without rounding the RGB channels.
+func NewCMYKAExact(c float64, m float64, y float64, k float64, a float64) Color {
+	r, g, b := CMYKToRGBExact(c, m, y, k)
+	return newColor(r, g, b, a)
+}
+
 // Mix mixs both color with the specified weight of the second color. (`0.5` as `50%`)
+// The more opaque color gets more weight in the RGB channels and the alpha channel is mixed by the weight, same as the `mix()` function of Sass.
+// Use `MixLinear` to mix in the linear light with the same alpha weighting, or `MixIn` to mix with the premultiplied alpha as CSS does
+// (the translucent colors get a different result from `Mix` even in the same color space).
+//
+// reference: https://sass-lang.com/documentation/modules/color#mix
 func (c Color) Mix(color Color, weight float64) Color {
-	oWeight := 1 - weight
-	r := math.Round(oWeight*c.Red + weight*color.Red)
-	g := math.Round(oWeight*c.Green + weight*color.Green)
-	b := math.Round(oWeight*c.Blue + weight*color.Blue)
-	a := math.Round(oWeight*c.Alpha + weight*color.Alpha)
-	return newColor(r, g, b, a)
+	w1, alpha := mixWeight(c, color, weight)
+	w2 := 1 - w1
+	r := w1*c.Red + w2*color.Red
+	g := w1*c.Green + w2*color.Green
+	b := w1*c.Blue + w2*color.Blue
+	return newColor(r, g, b, alpha)
+}
+
+// mixWeight returns the weight of the first color for the RGB channels and the mixed alpha channel of the `mix()` function of Sass.
+func mixWeight(c Color, color Color, weight float64) (w1 float64, alpha float64) {
+	p := 1 - weight
+	w := 2*p - 1
+	a := c.Alpha - color.Alpha
+	if w*a == -1 {
+		w1 = (w + 1) / 2
+	} else {
+		w1 = ((w+a)/(1+w*a) + 1) / 2
+	}
+	return w1, p*c.Alpha + weight*color.Alpha
 }
 
 // Hue returns the Hue angle of the current color based on the HSL algorithm.
@@ -424,7 +516,7 @@ func (c Color) Lightness() float64 {
 
 // AdjustHue rotates the Hue angle of the color based on HSL mode, it still goes clockwise if the value was set over than 360 degree.
 func (c Color) AdjustHue(degrees float64) Color {
-	h, s, l := c.HSL()
+	h, s, l := c.HSLExact()
 	h += degrees
 	for {
 		if h >= 0 && h <= 360 {
@@ -436,55 +528,55 @@ func (c Color) AdjustHue(degrees float64) Color {
 			h += -360
 		}
 	}
-	r, g, b := HSLToRGB(h, s, l)
+	r, g, b := HSLToRGBExact(h, s, l)
 	return newColor(r, g, b, c.Alpha)
 }
 
 // Lighten increases the brightness of the color based on HSL mode. (`0.5` as `50%`)
 func (c Color) Lighten(percent float64) Color {
 	percent = percent * 100
-	h, s, l := c.HSL()
+	h, s, l := c.HSLExact()
 	l += percent
 	if l > 100 {
 		l = 100
 	}
-	r, g, b := HSLToRGB(h, s, l)
+	r, g, b := HSLToRGBExact(h, s, l)
 	return newColor(r, g, b, c.Alpha)
 }
 
 // Darken decreases the brightness of the color based on HSL mode. (`0.5` as `50%`)
 func (c Color) Darken(percent float64) Color {
 	percent = percent * 100
-	h, s, l := c.HSL()
+	h, s, l := c.HSLExact()
 	l -= percent
 	if l < 0 {
 		l = 0
 	}
-	r, g, b := HSLToRGB(h, s, l)
+	r, g, b := HSLToRGBExact(h, s, l)
 	return newColor(r, g, b, c.Alpha)
 }
 
 // Saturate increases the saturation of the color based on HSL mode. (`0.5` as `50%`)
 func (c Color) Saturate(percent float64) Color {
 	percent = percent * 100
-	h, s, l := c.HSL()
+	h, s, l := c.HSLExact()
 	s += percent
 	if s > 100 {
 		s = 100
 	}
-	r, g, b := HSLToRGB(h, s, l)
+	r, g, b := HSLToRGBExact(h, s, l)
 	return newColor(r, g, b, c.Alpha)
 }
 
 // Desaturate decreases the saturation of the color based on HSL mode. (`0.5` as `50%`)
 func (c Color) Desaturate(percent float64) Color {
 	percent = percent * 100
-	h, s, l := c.HSL()
+	h, s, l := c.HSLExact()
 	s -= percent
 	if s < 0 {
 		s = 0
 	}
-	r, g, b := HSLToRGB(h, s, l)
+	r, g, b := HSLToRGBExact(h, s, l)
 	return newColor(r, g, b, c.Alpha)
 }
 
@@ -499,11 +591,13 @@ func (c Color) Complement() Color {
 }
 
 // Tint increases the brightness of the color while keeping the color tone, same as `Mix` with a white color. (`0.5` as `50%`)
+// Use `TintIn` to mix in the linear light or the other color spaces.
 func (c Color) Tint(percent float64) Color {
 	return c.Mix(newColor(255, 255, 255, c.Alpha), percent)
 }
 
 // Shade decreases the brightness of the color while keeping the color tone, same as `Mix` with a black color. (`0.5` as `50%`)
+// Use `ShadeIn` to mix in the linear light or the other color spaces.
 func (c Color) Shade(percent float64) Color {
 	return c.Mix(newColor(0, 0, 0, c.Alpha), percent)
 }
@@ -517,27 +611,27 @@ func (c Color) Invert() Color {
 	return newColor(r, g, b, c.Alpha)
 }
 
-// LuminanaceWCAG returns the Luminance of the the current color based on the WCAG 2.0 algorithm.
+// RelativeLuminance returns the relative luminance (from `0` as black to `1` as white) of the current color based on the WCAG 2.x definition, the value is not rounded.
+// The sRGB channels are linearized with the `0.04045` threshold of the sRGB specification.
+//
+// reference: https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+func (c Color) RelativeLuminance() float64 {
+	r, g, b := rgbToLinear(c.Red, c.Green, c.Blue)
+	return r*0.2126 + g*0.7152 + b*0.0722
+}
+
+// LuminanaceWCAG returns the Luminance of the the current color based on the WCAG 2.0 algorithm, it's rounded to 2 decimal places.
+// Use `RelativeLuminance` for the unrounded value.
 //
 // reference: https://www.w3.org/TR/WCAG20-TECHS/G17.html#G17-tests
 //
 // reference: https://medium.com/dev-channel/using-sass-to-automatically-pick-text-colors-4ba7645d2796
 func (c Color) LuminanaceWCAG() float64 {
-	rgb := []float64{c.Red, c.Green, c.Blue}
-	for k, v := range rgb {
-		v /= 255
-		if v <= 0.03928 {
-			v = v / 12.92
-		} else {
-			v = math.Pow((v+0.055)/1.055, 2.4)
-		}
-		rgb[k] = v
-	}
-	v := rgb[0]*0.2126 + rgb[1]*0.7152 + rgb[2]*0.0722
-	return math.Round(v*100) / 100
+	return math.Round(c.RelativeLuminance()*100) / 100
 }
 
-// Luminanace returns the Luminance of the current color.
+// Luminanace returns the Luminance (from `0` to `255`) of the current color without the gamma correction, it's rounded to 2 decimal places.
+// It's a quick brightness estimation, use `RelativeLuminance` for the WCAG relative luminance.
 //
 // reference: https://en.wikipedia.org/wiki/Relative_luminance
 //
@@ -550,6 +644,7 @@ func (c Color) Luminanace() float64 {
 }
 
 // Foreground returns suggested foreground color by calculating the color luminance, it returns a white color when the color is dark, vise versa.
+// Use `BestForeground` or `EnsureContrast` to meet a WCAG contrast ratio.
 func (c Color) Foreground() Color {
 	white := NewRGB(255, 255, 255)
 	black := NewRGB(0, 0, 0)
@@ -560,22 +655,25 @@ func (c Color) Foreground() Color {
 }
 
 // Brighten increases the brightness of the color. (`0.5` as `50%`)
+// Use `BrightenLinear` to add the light in the linear light.
 //
 // reference: https://github.com/ozdemirburak/iris
 func (c Color) Brighten(percent float64) Color {
 	percent *= -100
-	r := math.Max(0, math.Min(255, c.Red-math.Round(255*(percent/100))))
-	g := math.Max(0, math.Min(255, c.Green-math.Round(255*(percent/100))))
-	b := math.Max(0, math.Min(255, c.Blue-math.Round(255*(percent/100))))
+	r := math.Max(0, math.Min(255, c.Red-255*(percent/100)))
+	g := math.Max(0, math.Min(255, c.Green-255*(percent/100)))
+	b := math.Max(0, math.Min(255, c.Blue-255*(percent/100)))
 	return newColor(r, g, b, c.Alpha)
 }
 
-// Contrast returns the Contrast of the current color based on the WCAG Luminance algorithm.
+// Contrast returns the WCAG contrast ratio (from `1` to `21`) between the current color and the specified color based on the relative luminance,
+// the value is not rounded so it can be compared with the thresholds (like: `4.5` and `3`) precisely.
+//
+// reference: https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
 func (c Color) Contrast(color Color) float64 {
-	c1 := c.LuminanaceWCAG() + 0.05
-	c2 := color.LuminanaceWCAG() + 0.05
-	v := math.Max(c1, c2) / math.Min(c1, c2)
-	return math.Round(v*100) / 100
+	c1 := c.RelativeLuminance() + 0.05
+	c2 := color.RelativeLuminance() + 0.05
+	return math.Max(c1, c2) / math.Min(c1, c2)
 }
 
 // IsLight returns true if the color is a light scheme, it might not be the same as what human eyes can see.
@@ -594,6 +692,11 @@ func (c Color) HSV() (float64, float64, float64) {
 	return RGBToHSV(c.Red, c.Green, c.Blue)
 }
 
+// HSVExact returns the HSV value of the current color without rounding.
+func (c Color) HSVExact() (float64, float64, float64) {
+	return RGBToHSVExact(c.Red, c.Green, c.Blue)
+}
+
 // HSVA returns the HSVA value of the current color.
 func (c Color) HSVA() (float64, float64, float64, float64) {
 	h, s, v := RGBToHSV(c.Red, c.Green, c.Blue)
@@ -605,6 +708,11 @@ func (c Color) HSL() (float64, float64, float64) {
 	return RGBToHSL(c.Red, c.Green, c.Blue)
 }
 
+// HSLExact returns the HSL value of the current color without rounding.
+func (c Color) HSLExact() (float64, float64, float64) {
+	return RGBToHSLExact(c.Red, c.Green, c.Blue)
+}
+
 // HSLA returns the HSLA value of the current color.
 func (c Color) HSLA() (float64, float64, float64, float64) {
 	h, s, l := RGBToHSL(c.Red, c.Green, c.Blue)
@@ -626,6 +734,11 @@ func (c Color) CMYK() (float64, float64, float64, float64) {
 	return RGBToCMYK(c.Red, c.Green, c.Blue)
 }
 
+// CMYKExact returns the CMYK value of the current color without rounding.
+func (c Color) CMYKExact() (float64, float64, float64, float64) {
+	return RGBToCMYKExact(c.Red, c.Green, c.Blue)
+}
+
 // Hex returns a Hex string of the current color. (Without the `#` prefix)
 func (c Color) Hex() string {
 	return RGBToHex(c.Red, c.Green, c.Blue)
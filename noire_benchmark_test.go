@@ -1,6 +1,7 @@
 package noire
 
 import (
+	"image"
 	"testing"
 )
 
@@ -336,3 +337,108 @@ func BenchmarkHTMLRGBA(b *testing.B) {
 		c.HTML()
 	}
 }
+
+func BenchmarkParseHex(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ParseHex("DB7093")
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Parse("rgb(219 112 147 / 50%)")
+	}
+}
+
+func BenchmarkNearestName(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		NewHex("4783B5").NearestName()
+	}
+}
+
+func BenchmarkRGBToHSLExact(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		RGBToHSLExact(219, 112, 148)
+	}
+}
+
+func BenchmarkHSLToRGBExact(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		HSLToRGBExact(340, 59.8, 64.9)
+	}
+}
+
+func BenchmarkRGBToHSLExactLoop(b *testing.B) {
+	src := make([]float64, 3*1024)
+	for i := range src {
+		src[i] = float64(i % 256)
+	}
+	dst := make([]float64, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < len(src); i += 3 {
+			dst[i], dst[i+1], dst[i+2] = RGBToHSLExact(src[i], src[i+1], src[i+2])
+		}
+	}
+}
+
+func BenchmarkConvertRGBToHSLExact(b *testing.B) {
+	src := make([]float64, 3*1024)
+	for i := range src {
+		src[i] = float64(i % 256)
+	}
+	dst := make([]float64, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ConvertRGBToHSLExact(dst, src)
+	}
+}
+
+func BenchmarkConvertRGBToOKLab(b *testing.B) {
+	src := make([]float64, 3*1024)
+	for i := range src {
+		src[i] = float64(i % 256)
+	}
+	dst := make([]float64, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ConvertRGBToOKLab(dst, src)
+	}
+}
+
+func BenchmarkToHexAll(b *testing.B) {
+	colors := make([]Color, 1024)
+	for i := range colors {
+		colors[i] = NewRGB(float64(i%256), 112, 147)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ToHexAll(colors)
+	}
+}
+
+func BenchmarkMapNRGBA(b *testing.B) {
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 32))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		MapNRGBA(img, Color.Invert)
+	}
+}
@@ -1,7 +1,9 @@
 package noire
 
 import (
+	"math"
 	"testing"
+	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -67,6 +69,13 @@ func TestHexToRGB(t *testing.T) {
 	assert := assert.New(t)
 	r, g, b := HexToRGB("DB7093")
 	assert.Equal([]float64{219, 112, 147}, []float64{r, g, b})
+	// The alpha channel is ignored.
+	r, g, b = HexToRGB("DB709380")
+	assert.Equal([]float64{219, 112, 147}, []float64{r, g, b})
+	r, g, b = HexToRGB("#F008")
+	assert.Equal([]float64{255, 0, 0}, []float64{r, g, b})
+	assert.Equal("DB7093", NewHex("#DB709380").Hex())
+	assert.Equal(0.5, NewHexA("DB709380", 0.5).Alpha)
 }
 
 func TestHTMLToRGB(t *testing.T) {
@@ -129,7 +138,7 @@ func TestLightness(t *testing.T) {
 func TestAdjustHue(t *testing.T) {
 	assert := assert.New(t)
 	c := NewRGB(219, 112, 148).AdjustHue(30)
-	assert.Equal("DB8270", c.Hex())
+	assert.Equal("DB8170", c.Hex())
 	c = NewRGB(219, 112, 148).AdjustHue(360)
 	assert.Equal("DB7094", c.Hex())
 	c = NewRGB(219, 112, 148).AdjustHue(480)
@@ -209,6 +218,15 @@ func TestLuminanaceWCAG(t *testing.T) {
 	assert.Equal(0.29, c.LuminanaceWCAG())
 }
 
+func TestRelativeLuminance(t *testing.T) {
+	assert := assert.New(t)
+	assert.InDelta(0.2879, NewRGB(219, 112, 148).RelativeLuminance(), 1e-4)
+	assert.Equal(0.0, NewRGB(0, 0, 0).RelativeLuminance())
+	assert.InDelta(1, NewRGB(255, 255, 255).RelativeLuminance(), 1e-12)
+	// The channel of `10` (`0.0392`) is under both thresholds, `0.04045` and `0.03928`.
+	assert.InDelta(10.0/255/12.92, NewRGB(10, 10, 10).RelativeLuminance(), 1e-12)
+}
+
 func TestLuminanace(t *testing.T) {
 	assert := assert.New(t)
 	c := NewRGB(219, 112, 148)
@@ -235,7 +253,12 @@ func TestContrast(t *testing.T) {
 	assert := assert.New(t)
 	c1 := NewRGB(219, 112, 148)
 	c2 := NewRGB(0, 0, 0)
-	assert.Equal(6.8, c1.Contrast(c2))
+	assert.InDelta(6.7573, c1.Contrast(c2), 1e-4)
+	assert.Equal(21.0, NewRGB(255, 255, 255).Contrast(c2))
+	assert.Equal(1.0, c1.Contrast(c1))
+	// #777 on white is below 4.5:1, the rounded luminance (`0.18`) made it pass.
+	assert.InDelta(4.4781, NewHex("777").Contrast(NewHex("FFF")), 1e-4)
+	assert.Less(NewHex("777").Contrast(NewHex("FFF")), 4.5)
 }
 
 func TestIsLight(t *testing.T) {
@@ -335,3 +358,115 @@ func TestHTML(t *testing.T) {
 	c.Alpha = 0.5
 	assert.Equal("rgba(219.000000, 112.000000, 147.000000, 0.500000)", c.HTML())
 }
+
+func TestCMYKToRGBExact(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := CMYKToRGBExact(0, 49, 33, 14)
+	assert.InDeltaSlice([]float64{219.3, 111.843, 146.931}, []float64{r, g, b}, 1e-9)
+}
+
+func TestRGBToCMYKExact(t *testing.T) {
+	assert := assert.New(t)
+	c, m, y, k := RGBToCMYKExact(219, 112, 148)
+	assert.InDeltaSlice([]float64{0, 48.858, 32.420, 14.118}, []float64{c, m, y, k}, 1e-3)
+}
+
+func TestRGBToHSLExact(t *testing.T) {
+	assert := assert.New(t)
+	h, s, l := RGBToHSLExact(219, 112, 148)
+	assert.InDeltaSlice([]float64{339.813, 59.777, 64.902}, []float64{h, s, l}, 1e-3)
+}
+
+func TestHSLToRGBExact(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := HSLToRGBExact(340, 59.8, 64.9)
+	assert.InDeltaSlice([]float64{219.019, 111.971, 147.654}, []float64{r, g, b}, 1e-3)
+}
+
+func TestHSVToRGBExact(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := HSVToRGBExact(340, 48.9, 85.9)
+	assert.InDeltaSlice([]float64{219.045, 111.931, 147.636}, []float64{r, g, b}, 1e-3)
+}
+
+func TestRGBToHSVExact(t *testing.T) {
+	assert := assert.New(t)
+	h, s, v := RGBToHSVExact(219, 112, 148)
+	assert.InDeltaSlice([]float64{339.813, 48.858, 85.882}, []float64{h, s, v}, 1e-3)
+}
+
+func TestNewExact(t *testing.T) {
+	assert := assert.New(t)
+	c := NewHSLExact(340, 59.8, 64.9)
+	assert.InDelta(147.654, c.Blue, 1e-3)
+	c = NewHSLAExact(340, 59.8, 64.9, 0.5)
+	assert.Equal(0.5, c.Alpha)
+	c = NewHSVExact(340, 48.9, 85.9)
+	assert.InDelta(147.636, c.Blue, 1e-3)
+	c = NewHSVAExact(340, 48.9, 85.9, 0.5)
+	assert.Equal(0.5, c.Alpha)
+	c = NewCMYKExact(0, 49, 33, 14)
+	assert.InDelta(146.931, c.Blue, 1e-3)
+	c = NewCMYKAExact(0, 49, 33, 14, 0.5)
+	assert.Equal(0.5, c.Alpha)
+}
+
+func TestExact(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 148)
+	h, s, l := c.HSLExact()
+	assert.InDeltaSlice([]float64{339.813, 59.777, 64.902}, []float64{h, s, l}, 1e-3)
+	h, s, v := c.HSVExact()
+	assert.InDeltaSlice([]float64{339.813, 48.858, 85.882}, []float64{h, s, v}, 1e-3)
+	cy, m, y, k := c.CMYKExact()
+	assert.InDeltaSlice([]float64{0, 48.858, 32.420, 14.118}, []float64{cy, m, y, k}, 1e-3)
+}
+
+// quickRGB maps the random numbers to a valid RGB color.
+func quickRGB(r uint8, g uint8, b uint8, f float64) (float64, float64, float64) {
+	f = math.Abs(math.Mod(f, 1))
+	if math.IsNaN(f) {
+		f = 0
+	}
+	return math.Min(255, float64(r)+f), float64(g), math.Max(0, float64(b)-f)
+}
+
+func TestExactRoundTrip(t *testing.T) {
+	equal := func(a []float64, b []float64) bool {
+		for i := range a {
+			if math.Abs(a[i]-b[i]) > 1e-9 {
+				return false
+			}
+		}
+		return true
+	}
+	hsl := func(r8 uint8, g8 uint8, b8 uint8, f float64) bool {
+		r, g, b := quickRGB(r8, g8, b8, f)
+		r2, g2, b2 := HSLToRGBExact(RGBToHSLExact(r, g, b))
+		return equal([]float64{r, g, b}, []float64{r2, g2, b2})
+	}
+	hsv := func(r8 uint8, g8 uint8, b8 uint8, f float64) bool {
+		r, g, b := quickRGB(r8, g8, b8, f)
+		r2, g2, b2 := HSVToRGBExact(RGBToHSVExact(r, g, b))
+		return equal([]float64{r, g, b}, []float64{r2, g2, b2})
+	}
+	cmyk := func(r8 uint8, g8 uint8, b8 uint8, f float64) bool {
+		r, g, b := quickRGB(r8, g8, b8, f)
+		r2, g2, b2 := CMYKToRGBExact(RGBToCMYKExact(r, g, b))
+		return equal([]float64{r, g, b}, []float64{r2, g2, b2})
+	}
+	chain := func(r8 uint8, g8 uint8, b8 uint8, f float64) bool {
+		c := NewRGB(quickRGB(r8, g8, b8, f))
+		v := c.AdjustHue(90).AdjustHue(-90).Saturate(0).Desaturate(0)
+		_, _, l := c.HSLExact()
+		if l < 79 {
+			v = v.Lighten(0.2).Darken(0.2)
+		}
+		return equal([]float64{c.Red, c.Green, c.Blue}, []float64{v.Red, v.Green, v.Blue})
+	}
+	for _, fn := range []interface{}{hsl, hsv, cmyk, chain} {
+		if err := quick.Check(fn, &quick.Config{MaxCount: 2000}); err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package noire
+
+// RGBToOKLab converts the color from RGB to OKLab, the lightness ranges from `0` to `1`.
+//
+// reference: https://bottosson.github.io/posts/oklab/
+func RGBToOKLab(r float64, g float64, b float64) (float64, float64, float64) {
+	return linearToOKLab(rgbToLinear(r, g, b))
+}
+
+// OKLabToRGB converts the color from OKLab to RGB, the result can be out of the `0` - `255` range if the color is outside of the sRGB gamut.
+//
+// reference: https://bottosson.github.io/posts/oklab/
+func OKLabToRGB(l float64, a float64, b float64) (float64, float64, float64) {
+	return linearToRGB(okLabToLinear(l, a, b))
+}
+
+// OKLabToOKLCH converts the color from OKLab to OKLCH, the hue ranges from `0` to `360`.
+func OKLabToOKLCH(l float64, a float64, b float64) (float64, float64, float64) {
+	c, h := rectangularToPolar(a, b)
+	return l, c, h
+}
+
+// OKLCHToOKLab converts the color from OKLCH to OKLab.
+func OKLCHToOKLab(l float64, c float64, h float64) (float64, float64, float64) {
+	a, b := polarToRectangular(c, h)
+	return l, a, b
+}
+
+// NewOKLab initializes a color based on OKLab.
+func NewOKLab(l float64, a float64, b float64) Color {
+	r, g, bl := OKLabToRGB(l, a, b)
+	return newColor(r, g, bl, 1)
+}
+
+// NewOKLabA initializes a color based on OKLab with an alpha channel.
+func NewOKLabA(l float64, a float64, b float64, alpha float64) Color {
+	r, g, bl := OKLabToRGB(l, a, b)
+	return newColor(r, g, bl, alpha)
+}
+
+// NewOKLCH initializes a color based on OKLCH.
+func NewOKLCH(l float64, c float64, h float64) Color {
+	return NewOKLab(OKLCHToOKLab(l, c, h))
+}
+
+// NewOKLCHA initializes a color based on OKLCH with an alpha channel.
+func NewOKLCHA(l float64, c float64, h float64, a float64) Color {
+	l, x, y := OKLCHToOKLab(l, c, h)
+	return NewOKLabA(l, x, y, a)
+}
+
+// OKLab returns the OKLab value of the current color, the values are not rounded.
+func (c Color) OKLab() (float64, float64, float64) {
+	return RGBToOKLab(c.Red, c.Green, c.Blue)
+}
+
+// OKLabA returns the OKLab value of the current color with the alpha channel.
+func (c Color) OKLabA() (float64, float64, float64, float64) {
+	l, a, b := RGBToOKLab(c.Red, c.Green, c.Blue)
+	return l, a, b, c.Alpha
+}
+
+// OKLCH returns the OKLCH value of the current color, the values are not rounded.
+func (c Color) OKLCH() (float64, float64, float64) {
+	return OKLabToOKLCH(c.OKLab())
+}
+
+// OKLCHA returns the OKLCH value of the current color with the alpha channel.
+func (c Color) OKLCHA() (float64, float64, float64, float64) {
+	l, ch, h := OKLabToOKLCH(c.OKLab())
+	return l, ch, h, c.Alpha
+}
@@ -0,0 +1,63 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRGBToOKLab(t *testing.T) {
+	assert := assert.New(t)
+	l, a, b := RGBToOKLab(255, 255, 255)
+	assert.InDeltaSlice([]float64{1, 0, 0}, []float64{l, a, b}, 1e-4)
+	l, a, b = RGBToOKLab(255, 0, 0)
+	assert.InDeltaSlice([]float64{0.6280, 0.2249, 0.1258}, []float64{l, a, b}, 1e-4)
+}
+
+func TestOKLabToRGB(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := OKLabToRGB(0.6280, 0.2249, 0.1258)
+	assert.InDeltaSlice([]float64{255, 0, 0}, []float64{r, g, b}, 0.5)
+}
+
+func TestOKLabToOKLCH(t *testing.T) {
+	assert := assert.New(t)
+	l, c, h := OKLabToOKLCH(0.5, -0.1, 0)
+	assert.InDeltaSlice([]float64{0.5, 0.1, 180}, []float64{l, c, h}, 1e-9)
+}
+
+func TestOKLCHToOKLab(t *testing.T) {
+	assert := assert.New(t)
+	l, a, b := OKLCHToOKLab(0.5, 0.1, 180)
+	assert.InDeltaSlice([]float64{0.5, -0.1, 0}, []float64{l, a, b}, 1e-9)
+}
+
+func TestNewOKLab(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("FF0000", NewOKLab(0.6280, 0.2249, 0.1258).Hex())
+	assert.Equal(0.5, NewOKLabA(0.6280, 0.2249, 0.1258, 0.5).Alpha)
+}
+
+func TestNewOKLCH(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("FF0000", NewOKLCH(0.6280, 0.2577, 29.23).Hex())
+	assert.Equal(0.5, NewOKLCHA(0.6280, 0.2577, 29.23, 0.5).Alpha)
+}
+
+func TestOKLab(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(219, 112, 147)
+	l, a, b := c.OKLab()
+	assert.InDeltaSlice([]float64{0.6779, 0.1382, 0.0016}, []float64{l, a, b}, 1e-4)
+	l, a, b, alpha := c.OKLabA()
+	assert.InDeltaSlice([]float64{0.6779, 0.1382, 0.0016, 1}, []float64{l, a, b, alpha}, 1e-4)
+}
+
+func TestOKLCH(t *testing.T) {
+	assert := assert.New(t)
+	c := NewRGB(255, 0, 0)
+	l, ch, h := c.OKLCH()
+	assert.InDeltaSlice([]float64{0.6280, 0.2577, 29.23}, []float64{l, ch, h}, 1e-2)
+	l, ch, h, a := c.OKLCHA()
+	assert.InDeltaSlice([]float64{0.6280, 0.2577, 29.23, 1}, []float64{l, ch, h, a}, 1e-2)
+}
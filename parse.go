@@ -0,0 +1,124 @@
+package noire
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidLength is returned when a Hex string doesn't have 3 or 6 digits.
+	ErrInvalidLength = errors.New("noire: invalid length")
+	// ErrInvalidDigit is returned when a Hex string contains a non-hexadecimal character.
+	ErrInvalidDigit = errors.New("noire: invalid digit")
+	// ErrUnknownName is returned when a HTML color name is not known.
+	ErrUnknownName = errors.New("noire: unknown color name")
+	// ErrInvalidSyntax is returned when a CSS color string is malformed.
+	ErrInvalidSyntax = errors.New("noire: invalid syntax")
+)
+
+// ParseError records a failed parsing, `Pos` is the byte offset of the offending character in `Input`.
+type ParseError struct {
+	Func  string
+	Input string
+	Pos   int
+	Err   error
+}
+
+// Error returns the error message with the offending position.
+func (e *ParseError) Error() string {
+	return e.Func + ": parsing " + strconv.Quote(e.Input) + " at position " + strconv.Itoa(e.Pos) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error so it can be compared with `errors.Is`.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseHex parses the Hex string (can be `#` prefixed or either a 3 characters shorthand) to RGB.
+func parseHex(fn string, h string) (r float64, g float64, b float64, err error) {
+	r, g, b, _, err = parseHexAlpha(fn, h, false)
+	return
+}
+
+// parseHexAlpha parses the Hex string to RGB, the 4 and 8 digits forms with an alpha channel are accepted if `alpha` is true.
+func parseHexAlpha(fn string, h string, alpha bool) (r float64, g float64, b float64, a float64, err error) {
+	offset := 0
+	if strings.HasPrefix(h, "#") {
+		offset = 1
+	}
+	digits := h[offset:]
+	n := len(digits)
+	if n != 3 && n != 6 && (!alpha || n != 4 && n != 8) {
+		err = &ParseError{Func: fn, Input: h, Pos: len(h), Err: ErrInvalidLength}
+		return
+	}
+	v := [8]byte{15, 15, 15, 15, 15, 15, 15, 15}
+	for i := 0; i < n; i++ {
+		d, ok := hexDigit(digits[i])
+		if !ok {
+			err = &ParseError{Func: fn, Input: h, Pos: offset + i, Err: ErrInvalidDigit}
+			return
+		}
+		if n <= 4 {
+			v[i*2] = d
+			v[i*2+1] = d
+		} else {
+			v[i] = d
+		}
+	}
+	r = float64(v[0]<<4 | v[1])
+	g = float64(v[2]<<4 | v[3])
+	b = float64(v[4]<<4 | v[5])
+	a = float64(v[6]<<4|v[7]) / 255
+	return
+}
+
+// hexDigit returns the value of a hexadecimal character.
+func hexDigit(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// ParseHex initializes a color based on a Hex string, it returns an error if the string is not a valid Hex color.
+func ParseHex(color string) (Color, error) {
+	r, g, b, err := parseHex("ParseHex", color)
+	if err != nil {
+		return Color{}, err
+	}
+	return newColor(r, g, b, 1), nil
+}
+
+// ParseHexA initializes a color based on a Hex string with an alpha channel, it returns an error if the string is not a valid Hex color.
+func ParseHexA(color string, a float64) (Color, error) {
+	r, g, b, err := parseHex("ParseHexA", color)
+	if err != nil {
+		return Color{}, err
+	}
+	return newColor(r, g, b, a), nil
+}
+
+// ParseHTML initializes a color based on the HTML color name or a `#` prefixed Hex string, it returns an error if the name is unknown.
+func ParseHTML(color string) (Color, error) {
+	r, g, b, err := cssPalette.parseHTML("ParseHTML", color)
+	if err != nil {
+		return Color{}, err
+	}
+	return newColor(r, g, b, 1), nil
+}
+
+// ParseHTMLA initializes a color based on the HTML color name or a `#` prefixed Hex string with an alpha channel, it returns an error if the name is unknown.
+func ParseHTMLA(color string, a float64) (Color, error) {
+	r, g, b, err := cssPalette.parseHTML("ParseHTMLA", color)
+	if err != nil {
+		return Color{}, err
+	}
+	return newColor(r, g, b, a), nil
+}
@@ -0,0 +1,74 @@
+package noire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHex(t *testing.T) {
+	assert := assert.New(t)
+	c, err := ParseHex("#DB7093")
+	assert.NoError(err)
+	assert.Equal("DB7093", c.Hex())
+	c, err = ParseHex("f00")
+	assert.NoError(err)
+	assert.Equal("FF0000", c.Hex())
+
+	_, err = ParseHex("")
+	assert.True(errors.Is(err, ErrInvalidLength))
+	_, err = ParseHex("#DB70")
+	assert.True(errors.Is(err, ErrInvalidLength))
+
+	_, err = ParseHex("#zz0")
+	assert.True(errors.Is(err, ErrInvalidDigit))
+	var perr *ParseError
+	assert.True(errors.As(err, &perr))
+	assert.Equal(1, perr.Pos)
+	assert.Equal("ParseHex: parsing \"#zz0\" at position 1: noire: invalid digit", err.Error())
+}
+
+func TestParseHexA(t *testing.T) {
+	assert := assert.New(t)
+	c, err := ParseHexA("000", 0.5)
+	assert.NoError(err)
+	assert.Equal(0.5, c.Alpha)
+	_, err = ParseHexA("00000G", 0.5)
+	var perr *ParseError
+	assert.True(errors.As(err, &perr))
+	assert.Equal(5, perr.Pos)
+}
+
+func TestParseHTML(t *testing.T) {
+	assert := assert.New(t)
+	c, err := ParseHTML("PaleVioletRed")
+	assert.NoError(err)
+	assert.Equal("DB7093", c.Hex())
+	c, err = ParseHTML("#F0F0F0")
+	assert.NoError(err)
+	assert.Equal("F0F0F0", c.Hex())
+	_, err = ParseHTML("NinjaTurtle")
+	assert.True(errors.Is(err, ErrUnknownName))
+	_, err = ParseHTML("#F0F0F")
+	assert.True(errors.Is(err, ErrInvalidLength))
+}
+
+func TestParseHTMLA(t *testing.T) {
+	assert := assert.New(t)
+	c, err := ParseHTMLA("Red", 0.5)
+	assert.NoError(err)
+	assert.Equal(0.5, c.Alpha)
+	_, err = ParseHTMLA("", 0.5)
+	assert.True(errors.Is(err, ErrUnknownName))
+}
+
+func TestHexToRGBInvalid(t *testing.T) {
+	assert := assert.New(t)
+	assert.NotPanics(func() {
+		r, g, b := HexToRGB("")
+		assert.Equal([]float64{0, 0, 0}, []float64{r, g, b})
+		r, g, b = HexToRGB("#zz")
+		assert.Equal([]float64{0, 0, 0}, []float64{r, g, b})
+	})
+}
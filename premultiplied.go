@@ -0,0 +1,91 @@
+package noire
+
+import (
+	"image/color"
+	"math"
+)
+
+// PremultipliedColor is a color with the RGB channels (`0` - `255`) multiplied by the alpha channel (`0` - `1`),
+// so a transparent color is always a transparent black and can be interpolated or composited without the gray fringes.
+type PremultipliedColor struct {
+	Red   float64
+	Green float64
+	Blue  float64
+	Alpha float64
+}
+
+// Premultiply returns the current color with the RGB channels multiplied by the alpha channel.
+func (c Color) Premultiply() PremultipliedColor {
+	return PremultipliedColor{
+		Red:   c.Red * c.Alpha,
+		Green: c.Green * c.Alpha,
+		Blue:  c.Blue * c.Alpha,
+		Alpha: c.Alpha,
+	}
+}
+
+// Unpremultiply returns the color with the RGB channels divided by the alpha channel, it's a transparent black color if the alpha is `0`.
+func (p PremultipliedColor) Unpremultiply() Color {
+	if p.Alpha <= 0 {
+		return newColor(0, 0, 0, 0)
+	}
+	return newColor(p.Red/p.Alpha, p.Green/p.Alpha, p.Blue/p.Alpha, p.Alpha)
+}
+
+// Scale returns the color with all the channels (including the alpha) multiplied by the factor.
+func (p PremultipliedColor) Scale(factor float64) PremultipliedColor {
+	return PremultipliedColor{
+		Red:   p.Red * factor,
+		Green: p.Green * factor,
+		Blue:  p.Blue * factor,
+		Alpha: p.Alpha * factor,
+	}
+}
+
+// Add returns the sum of both colors, the channels are not clamped.
+func (p PremultipliedColor) Add(color PremultipliedColor) PremultipliedColor {
+	return PremultipliedColor{
+		Red:   p.Red + color.Red,
+		Green: p.Green + color.Green,
+		Blue:  p.Blue + color.Blue,
+		Alpha: p.Alpha + color.Alpha,
+	}
+}
+
+// Lerp returns the linear interpolation at `t` (from `0` to `1`) between both colors, it's the same as `MixIn` in `SpaceSRGB`.
+func (p PremultipliedColor) Lerp(color PremultipliedColor, t float64) PremultipliedColor {
+	return p.Scale(1 - t).Add(color.Scale(t))
+}
+
+// StdColor returns the color as a `color.RGBA64`, it's the premultiplied color of the standard library.
+func (p PremultipliedColor) StdColor() color.RGBA64 {
+	v := func(c float64) uint16 {
+		return uint16(math.Round(math.Max(0, math.Min(0xffff, c/255*0xffff))))
+	}
+	return color.RGBA64{
+		R: v(p.Red),
+		G: v(p.Green),
+		B: v(p.Blue),
+		A: uint16(math.Round(math.Max(0, math.Min(1, p.Alpha)) * 0xffff)),
+	}
+}
+
+// premultiply multiplies the components (except the hue at the index `hue`, can be `-1`) of a color space by the alpha channel.
+func premultiply(v [3]float64, a float64, hue int) [3]float64 {
+	for i := range v {
+		if i != hue {
+			v[i] *= a
+		}
+	}
+	return v
+}
+
+// unpremultiply divides the components (except the hue at the index `hue`, can be `-1`) of a color space by the alpha channel, the alpha must not be `0`.
+func unpremultiply(v [3]float64, a float64, hue int) [3]float64 {
+	for i := range v {
+		if i != hue {
+			v[i] /= a
+		}
+	}
+	return v
+}
@@ -0,0 +1,62 @@
+package noire
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPremultiply(t *testing.T) {
+	assert := assert.New(t)
+	p := NewRGBA(200, 100, 50, 0.5).Premultiply()
+	assert.Equal(PremultipliedColor{100, 50, 25, 0.5}, p)
+	assert.Equal(PremultipliedColor{0, 0, 0, 0}, NewRGBA(200, 100, 50, 0).Premultiply())
+	assert.Equal(PremultipliedColor{200, 100, 50, 1}, NewRGB(200, 100, 50).Premultiply())
+}
+
+func TestUnpremultiply(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(NewRGBA(200, 100, 50, 0.5), PremultipliedColor{100, 50, 25, 0.5}.Unpremultiply())
+	assert.Equal(NewRGBA(0, 0, 0, 0), PremultipliedColor{100, 50, 25, 0}.Unpremultiply())
+	assert.Equal(NewRGBA(255, 0, 0, 0.5), PremultipliedColor{200, 0, 0, 0.5}.Unpremultiply())
+
+	c := NewRGBA(219, 112, 147, 0.3).Premultiply().Unpremultiply()
+	assert.InDeltaSlice([]float64{219, 112, 147, 0.3}, []float64{c.Red, c.Green, c.Blue, c.Alpha}, 1e-9)
+}
+
+func TestPremultipliedLerp(t *testing.T) {
+	assert := assert.New(t)
+	// Interpolating from `transparent` only fades the color without the gray fringes.
+	p := NewRGBA(0, 0, 0, 0).Premultiply().Lerp(NewHTML("Red").Premultiply(), 0.5)
+	assert.Equal(PremultipliedColor{127.5, 0, 0, 0.5}, p)
+	assert.Equal(NewRGBA(255, 0, 0, 0.5), p.Unpremultiply())
+	assert.Equal(p.Unpremultiply(), NewRGBA(0, 0, 0, 0).MixIn(NewHTML("Red"), 0.5, SpaceSRGB))
+	// `Interpolate` in sRGB is the same as `Lerp`.
+	from, to := NewRGBA(219, 112, 147, 0.3), NewRGBA(20, 200, 90, 0.8)
+	for _, t := range []float64{0, 0.25, 0.5, 1} {
+		assert.Equal(from.Premultiply().Lerp(to.Premultiply(), t).Unpremultiply(), Interpolate(from, to, t, SpaceSRGB, HueShorter))
+	}
+
+	p = PremultipliedColor{10, 20, 30, 0.5}.Add(PremultipliedColor{1, 2, 3, 0.25}.Scale(2))
+	assert.Equal(PremultipliedColor{12, 24, 36, 1}, p)
+}
+
+func TestPremultipliedStdColor(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(color.RGBA64{R: 0x8000, G: 0, B: 0, A: 0x8000}, NewRGBA(255, 0, 0, 0.5).Premultiply().StdColor())
+	r, g, b, a := NewRGBA(219, 112, 147, 0.5).Premultiply().StdColor().RGBA()
+	r2, g2, b2, a2 := NewRGBA(219, 112, 147, 0.5).StdColor().RGBA()
+	assert.InDeltaSlice([]uint32{r2, g2, b2, a2}, []uint32{r, g, b, a}, 1)
+}
+
+func TestInterpolateTransparent(t *testing.T) {
+	assert := assert.New(t)
+	g := NewGradientColors(NewRGBA(0, 0, 0, 0), NewHTML("Red"))
+	for _, space := range []Space{SpaceSRGB, SpaceLinearSRGB, SpaceHSL, SpaceOKLab, SpaceOKLCH} {
+		g.Space = space
+		c := g.At(0.5)
+		assert.Equal("FF0000", c.Hex(), "space %d", space)
+		assert.Equal(0.5, c.Alpha)
+	}
+}
@@ -0,0 +1,109 @@
+package noire
+
+var (
+	// tonalPaletteTones are the tones generated by `TonalPalette` in order, same as Material Design.
+	tonalPaletteTones = []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 95, 99, 100}
+	// scaleStepOrder are the steps generated by `Scale` in order, same as Tailwind CSS.
+	scaleStepOrder = []int{50, 100, 200, 300, 400, 500, 600, 700, 800, 900, 950}
+)
+
+// TonalPaletteTones returns the fixed tones generated by `TonalPalette` in order (`0`, `10` to `90`, `95`, `99` and `100`), same as Material Design.
+// It returns a copy, so modifying it doesn't change the palette.
+func TonalPaletteTones() []int {
+	return append([]int(nil), tonalPaletteTones...)
+}
+
+// ScaleSteps returns the fixed steps generated by `Scale` in order (`50`, `100` to `900` and `950`), same as Tailwind CSS.
+// It returns a copy, so modifying it doesn't change the scale.
+func ScaleSteps() []int {
+	return append([]int(nil), scaleStepOrder...)
+}
+
+// scaleStep is the OKLCH lightness and the chroma ratio (to the seed color) of a step of `Scale`.
+type scaleStep struct {
+	lightness float64
+	chroma    float64
+}
+
+// scaleSteps are the lightness and chroma ratios of the steps of `Scale`, based on the default palette of Tailwind CSS.
+var scaleSteps = map[int]scaleStep{
+	50:  {0.97, 0.08},
+	100: {0.935, 0.17},
+	200: {0.885, 0.3},
+	300: {0.81, 0.5},
+	400: {0.71, 0.8},
+	500: {0.62, 1},
+	600: {0.545, 1},
+	700: {0.475, 0.95},
+	800: {0.405, 0.8},
+	900: {0.35, 0.65},
+	950: {0.26, 0.45},
+}
+
+// inGamut returns true if the RGB color is in the sRGB gamut.
+func inGamut(r float64, g float64, b float64) bool {
+	const e = 0.001
+	return r >= -e && r <= 255+e && g >= -e && g <= 255+e && b >= -e && b <= 255+e
+}
+
+// fitChroma reduces the chroma of a polar color until it's in the sRGB gamut, so the lightness and hue are kept.
+func fitChroma(l float64, c float64, h float64, toRGB func(l float64, c float64, h float64) (float64, float64, float64)) (float64, float64, float64) {
+	r, g, b := toRGB(l, c, h)
+	if inGamut(r, g, b) {
+		return r, g, b
+	}
+	lo, hi := 0.0, c
+	for i := 0; i < 24; i++ {
+		mid := (lo + hi) / 2
+		if inGamut(toRGB(l, mid, h)) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return toRGB(l, lo, h)
+}
+
+// lchToRGB converts the color from CIELCh (D65) to RGB.
+func lchToRGB(l float64, c float64, h float64) (float64, float64, float64) {
+	l, a, b := LChToLab(l, c, h)
+	return LabToRGB(l, a, b, D65)
+}
+
+// oklchToRGB converts the color from OKLCH to RGB.
+func oklchToRGB(l float64, c float64, h float64) (float64, float64, float64) {
+	return OKLabToRGB(OKLCHToOKLab(l, c, h))
+}
+
+// Tone returns the color with the CIELAB lightness of the tone (from `0` as black to `100` as white) while keeping the hue and chroma,
+// the chroma is reduced if the color is outside of the sRGB gamut. It's the same idea as the tones of Material Design.
+func (c Color) Tone(tone float64) Color {
+	_, ch, h := c.LCh()
+	r, g, b := fitChroma(tone, ch, h, lchToRGB)
+	return newColor(r, g, b, c.Alpha)
+}
+
+// TonalPalette returns the tones (`TonalPaletteTones`) of the current color like Material Design, the tone is the CIELAB lightness (see `Tone`).
+func (c Color) TonalPalette() map[int]Color {
+	p := make(map[int]Color, len(tonalPaletteTones))
+	for _, v := range tonalPaletteTones {
+		p[v] = c.Tone(float64(v))
+	}
+	return p
+}
+
+// Scale returns the steps (`ScaleSteps`, from `50` to `950`) of the current color like Tailwind CSS.
+// Every step has a fixed OKLCH lightness so the scales of different hues look consistent, and the chroma is scaled from the current color.
+// The lighter steps are rotated by up to `hueShift / 2` degrees and the darker steps by up to `-hueShift / 2` degrees, use `0` to keep the hue.
+func (c Color) Scale(hueShift float64) map[int]Color {
+	lightest, darkest := scaleSteps[50].lightness, scaleSteps[950].lightness
+	_, ch, h := c.OKLCH()
+	p := make(map[int]Color, len(scaleStepOrder))
+	for _, v := range scaleStepOrder {
+		s := scaleSteps[v]
+		shift := hueShift * (s.lightness - scaleSteps[500].lightness) / (lightest - darkest)
+		r, g, b := fitChroma(s.lightness, ch*s.chroma, h+shift, oklchToRGB)
+		p[v] = newColor(r, g, b, c.Alpha)
+	}
+	return p
+}
@@ -0,0 +1,76 @@
+package noire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTone(t *testing.T) {
+	assert := assert.New(t)
+	c := NewHex("3B82F6")
+	l, _, _ := c.Tone(40).Lab()
+	assert.InDelta(40, l, 1e-3)
+	assert.Equal("000000", c.Tone(0).Hex())
+	assert.Equal("FFFFFF", c.Tone(100).Hex())
+	assert.Equal(0.5, NewRGBA(59, 130, 246, 0.5).Tone(50).Alpha)
+
+	// The hue is kept while the chroma is reduced to fit the sRGB gamut.
+	_, _, h1 := c.LCh()
+	_, _, h2 := c.Tone(90).LCh()
+	assert.InDelta(h1, h2, 1)
+}
+
+func TestTonalPalette(t *testing.T) {
+	assert := assert.New(t)
+	p := NewHex("3B82F6").TonalPalette()
+	assert.Len(p, len(TonalPaletteTones()))
+	var hexes []string
+	for _, v := range TonalPaletteTones() {
+		hexes = append(hexes, p[v].Hex())
+	}
+	assert.Equal([]string{"000000", "001B3F", "002F66", "004590", "005CBC", "1C74E6", "528DFF", "8CA8FF", "B7C4FF", "DCE1FF", "EEF0FF", "FCFCFF", "FFFFFF"}, hexes)
+}
+
+func TestScale(t *testing.T) {
+	assert := assert.New(t)
+	p := NewHex("3B82F6").Scale(0)
+	assert.Len(p, len(ScaleSteps()))
+	var hexes []string
+	for _, v := range ScaleSteps() {
+		hexes = append(hexes, p[v].Hex())
+	}
+	assert.Equal([]string{"F0F6FF", "DEEAFF", "C4DBFF", "9DC2FE", "67A1FE", "3A81F5", "2269DB", "0F54BE", "0B4398", "0D3679", "08224C"}, hexes)
+
+	// The steps are copies, modifying them doesn't change the scale.
+	steps := append(ScaleSteps(), 975)
+	steps[0] = 1
+	tones := TonalPaletteTones()
+	tones[0] = 5
+	assert.Equal(50, ScaleSteps()[0])
+	assert.Equal(0, TonalPaletteTones()[0])
+	assert.Len(NewHex("3B82F6").Scale(0), 11)
+	assert.Len(NewHex("3B82F6").TonalPalette(), 13)
+
+	// The steps of different hues have the same lightness.
+	red := NewHex("EF4444").Scale(0)
+	for _, v := range ScaleSteps() {
+		l1, _, _ := p[v].OKLCH()
+		l2, _, _ := red[v].OKLCH()
+		assert.InDelta(l1, l2, 0.005)
+	}
+
+	p = NewHex("3B82F6").Scale(20)
+	assert.Equal("3A81F5", p[500].Hex())
+	assert.Equal("F1F5FF", p[50].Hex())
+	assert.Equal("002545", p[950].Hex())
+	assert.Equal("868686", NewHex("808080").Scale(20)[500].Hex())
+}
+
+func TestFitChroma(t *testing.T) {
+	assert := assert.New(t)
+	r, g, b := fitChroma(50, 200, 30, lchToRGB)
+	assert.True(inGamut(r, g, b))
+	r, g, b = fitChroma(50, 0, 30, lchToRGB)
+	assert.InDeltaSlice([]float64{118.91, 118.91, 118.91}, []float64{r, g, b}, 0.01)
+}
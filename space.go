@@ -0,0 +1,141 @@
+package noire
+
+import "math"
+
+// matrix3 is a 3x3 matrix used to convert between the linear color spaces.
+type matrix3 [3][3]float64
+
+// mul multiplies the matrix with the column vector of the three components.
+func (m matrix3) mul(x float64, y float64, z float64) (float64, float64, float64) {
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// reference: https://www.w3.org/TR/css-color-4/#color-conversion-code
+var (
+	linearRGBToXYZMatrix = matrix3{
+		{0.41239079926595934, 0.357584339383878, 0.1804807884018343},
+		{0.21263900587151027, 0.715168678767756, 0.07219231536073371},
+		{0.01933081871559182, 0.11919477979462598, 0.9505321522496607},
+	}
+	xyzToLinearRGBMatrix = matrix3{
+		{3.2409699419045226, -1.537383177570094, -0.4986107602930034},
+		{-0.9692436362808796, 1.8759675015077202, 0.04155505740717559},
+		{0.05563007969699366, -0.20397695888897652, 1.0569715142428786},
+	}
+	d65ToD50Matrix = matrix3{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+	d50ToD65Matrix = matrix3{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+)
+
+// whiteD50 and whiteD65 are the reference white of the standard illuminants (with `Y` normalized to 1).
+var (
+	whiteD50 = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+	whiteD65 = [3]float64{0.3127 / 0.3290, 1, (1 - 0.3127 - 0.3290) / 0.3290}
+)
+
+// rgbToLinear converts the RGB (`0` - `255`) to the linear light RGB (`0` - `1`).
+func rgbToLinear(r float64, g float64, b float64) (float64, float64, float64) {
+	return SRGBToLinear(r / 255), SRGBToLinear(g / 255), SRGBToLinear(b / 255)
+}
+
+// linearToRGB converts the linear light RGB (`0` - `1`) to RGB (`0` - `255`).
+func linearToRGB(r float64, g float64, b float64) (float64, float64, float64) {
+	return LinearToSRGB(r) * 255, LinearToSRGB(g) * 255, LinearToSRGB(b) * 255
+}
+
+// labF is the companding function from XYZ to Lab.
+func labF(t float64) float64 {
+	if t > 216.0/24389.0 {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27.0*t + 16) / 116
+}
+
+// labFInverse is the companding function from Lab to XYZ.
+func labFInverse(t float64) float64 {
+	if t*t*t > 216.0/24389.0 {
+		return t * t * t
+	}
+	return (116*t - 16) / (24389.0 / 27.0)
+}
+
+// xyzToLab converts the XYZ (with `Y` from `0` to `1`) to Lab relative to the white point.
+func xyzToLab(x float64, y float64, z float64, white [3]float64) (l float64, a float64, b float64) {
+	fx := labF(x / white[0])
+	fy := labF(y / white[1])
+	fz := labF(z / white[2])
+	l = 116*fy - 16
+	a = 500 * (fx - fy)
+	b = 200 * (fy - fz)
+	return
+}
+
+// labToXYZ converts the Lab to XYZ (with `Y` from `0` to `1`) relative to the white point.
+func labToXYZ(l float64, a float64, b float64, white [3]float64) (x float64, y float64, z float64) {
+	fy := (l + 16) / 116
+	fx := a/500 + fy
+	fz := fy - b/200
+	x = labFInverse(fx) * white[0]
+	if l > 8 {
+		y = fy * fy * fy
+	} else {
+		y = l / (24389.0 / 27.0)
+	}
+	y *= white[1]
+	z = labFInverse(fz) * white[2]
+	return
+}
+
+// rectangularToPolar converts the `a` and `b` axes to the chroma and the hue angle (`0` - `360`).
+func rectangularToPolar(a float64, b float64) (c float64, h float64) {
+	c = math.Sqrt(a*a + b*b)
+	h = math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return
+}
+
+// polarToRectangular converts the chroma and the hue angle to the `a` and `b` axes.
+func polarToRectangular(c float64, h float64) (a float64, b float64) {
+	rad := h * math.Pi / 180
+	a = c * math.Cos(rad)
+	b = c * math.Sin(rad)
+	return
+}
+
+// linearToOKLab converts the linear light RGB (`0` - `1`) to OKLab.
+//
+// reference: https://bottosson.github.io/posts/oklab/
+func linearToOKLab(r float64, g float64, b float64) (float64, float64, float64) {
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+	return 0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s
+}
+
+// okLabToLinear converts the OKLab to the linear light RGB (`0` - `1`).
+//
+// reference: https://bottosson.github.io/posts/oklab/
+func okLabToLinear(l float64, a float64, b float64) (float64, float64, float64) {
+	l1 := l + 0.3963377774*a + 0.2158037573*b
+	m1 := l - 0.1055613458*a - 0.0638541728*b
+	s1 := l - 0.0894841775*a - 1.2914855480*b
+	l1 = l1 * l1 * l1
+	m1 = m1 * m1 * m1
+	s1 = s1 * s1 * s1
+	return 4.0767416621*l1 - 3.3077115913*m1 + 0.2309699292*s1,
+		-1.2684380046*l1 + 2.6097574011*m1 - 0.3413193965*s1,
+		-0.0041960863*l1 - 0.7034186147*m1 + 1.7076147010*s1
+}
@@ -0,0 +1,35 @@
+package noire
+
+import (
+	"image/color"
+	"math"
+)
+
+// Model is the `color.Model` of the noire colors, it converts any color to a `color.NRGBA64` as `Color.StdColor` does.
+var Model = color.ModelFunc(stdModel)
+
+// stdModel converts the color of the standard library to a `color.NRGBA64`.
+func stdModel(c color.Color) color.Color {
+	if v, ok := c.(color.NRGBA64); ok {
+		return v
+	}
+	return FromStdColor(c).StdColor()
+}
+
+// FromStdColor initializes a color based on the `color.Color` of the standard library (like: `color.RGBA` or a pixel of `image.Image`).
+// The premultiplied alpha is converted to a straight alpha.
+func FromStdColor(c color.Color) Color {
+	v := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	return newColor(float64(v.R)/0xffff*255, float64(v.G)/0xffff*255, float64(v.B)/0xffff*255, float64(v.A)/0xffff)
+}
+
+// StdColor returns the current color as a `color.NRGBA64`, it implements the `color.Color` interface of the standard library,
+// so it can be used with the `image`, `image/draw` and `image/png` packages.
+func (c Color) StdColor() color.NRGBA64 {
+	return color.NRGBA64{
+		R: uint16(math.Round(c.Red / 255 * 0xffff)),
+		G: uint16(math.Round(c.Green / 255 * 0xffff)),
+		B: uint16(math.Round(c.Blue / 255 * 0xffff)),
+		A: uint16(math.Round(c.Alpha * 0xffff)),
+	}
+}
@@ -0,0 +1,42 @@
+package noire
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromStdColor(t *testing.T) {
+	assert := assert.New(t)
+	c := FromStdColor(color.NRGBA{R: 219, G: 112, B: 147, A: 255})
+	assert.Equal([]float64{219, 112, 147, 1}, []float64{c.Red, c.Green, c.Blue, c.Alpha})
+	c = FromStdColor(color.RGBA{R: 100, G: 0, B: 50, A: 128})
+	assert.Equal("C70064", c.Hex())
+	assert.InDelta(0.5, c.Alpha, 0.01)
+	c = FromStdColor(color.Gray{Y: 128})
+	assert.Equal("808080", c.Hex())
+	c = FromStdColor(color.Transparent)
+	assert.Equal(0.0, c.Alpha)
+}
+
+func TestStdColor(t *testing.T) {
+	assert := assert.New(t)
+	v := NewRGBA(219, 112, 147, 0.5).StdColor()
+	assert.Equal(color.NRGBA64{R: 0xdbdb, G: 0x7070, B: 0x9393, A: 0x8000}, v)
+	n := color.NRGBAModel.Convert(NewRGB(219, 112, 147).StdColor()).(color.NRGBA)
+	assert.Equal(color.NRGBA{R: 219, G: 112, B: 147, A: 255}, n)
+}
+
+func TestModel(t *testing.T) {
+	assert := assert.New(t)
+	v := Model.Convert(color.NRGBA{R: 255, G: 0, B: 0, A: 255})
+	assert.Equal(color.NRGBA64{R: 0xffff, A: 0xffff}, v)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	draw.Draw(img, img.Bounds(), image.NewUniform(NewHTML("PaleVioletRed").StdColor()), image.Point{}, draw.Src)
+	assert.Equal(color.NRGBA{R: 219, G: 112, B: 147, A: 255}, img.NRGBAAt(1, 1))
+	assert.Equal("PaleVioletRed", FromStdColor(img.At(0, 0)).HTML())
+}